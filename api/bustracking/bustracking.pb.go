@@ -9,6 +9,7 @@ package bustracking
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,6 +23,162 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type BusTrackingInfo_ScheduleMatch int32
+
+const (
+	BusTrackingInfo_EXACT           BusTrackingInfo_ScheduleMatch = 0 // Время GPS-данных в пределах смены
+	BusTrackingInfo_PRE_START       BusTrackingInfo_ScheduleMatch = 1 // Время GPS-данных в допуске до начала смены
+	BusTrackingInfo_POST_END        BusTrackingInfo_ScheduleMatch = 2 // Время GPS-данных в допуске после окончания смены
+	BusTrackingInfo_LAST_ASSIGNMENT BusTrackingInfo_ScheduleMatch = 3 // Расписание не найдено, используется последнее известное
+	BusTrackingInfo_DEFAULT_ROUTE   BusTrackingInfo_ScheduleMatch = 4 // Расписание не найдено, используется закрепленный за транспортом маршрут
+	BusTrackingInfo_OVERRIDE        BusTrackingInfo_ScheduleMatch = 5 // Назначение диспетчера, имеет приоритет над расписанием
+)
+
+// Enum value maps for BusTrackingInfo_ScheduleMatch.
+var (
+	BusTrackingInfo_ScheduleMatch_name = map[int32]string{
+		0: "EXACT",
+		1: "PRE_START",
+		2: "POST_END",
+		3: "LAST_ASSIGNMENT",
+		4: "DEFAULT_ROUTE",
+		5: "OVERRIDE",
+	}
+	BusTrackingInfo_ScheduleMatch_value = map[string]int32{
+		"EXACT":           0,
+		"PRE_START":       1,
+		"POST_END":        2,
+		"LAST_ASSIGNMENT": 3,
+		"DEFAULT_ROUTE":   4,
+		"OVERRIDE":        5,
+	}
+)
+
+func (x BusTrackingInfo_ScheduleMatch) Enum() *BusTrackingInfo_ScheduleMatch {
+	p := new(BusTrackingInfo_ScheduleMatch)
+	*p = x
+	return p
+}
+
+func (x BusTrackingInfo_ScheduleMatch) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BusTrackingInfo_ScheduleMatch) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_bustracking_proto_enumTypes[0].Descriptor()
+}
+
+func (BusTrackingInfo_ScheduleMatch) Type() protoreflect.EnumType {
+	return &file_api_proto_bustracking_proto_enumTypes[0]
+}
+
+func (x BusTrackingInfo_ScheduleMatch) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BusTrackingInfo_ScheduleMatch.Descriptor instead.
+func (BusTrackingInfo_ScheduleMatch) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type Adherence_Status int32
+
+const (
+	Adherence_ON_TIME Adherence_Status = 0 // Отклонение в допустимых пределах
+	Adherence_EARLY   Adherence_Status = 1 // Опережение расписания
+	Adherence_LATE    Adherence_Status = 2 // Опоздание
+)
+
+// Enum value maps for Adherence_Status.
+var (
+	Adherence_Status_name = map[int32]string{
+		0: "ON_TIME",
+		1: "EARLY",
+		2: "LATE",
+	}
+	Adherence_Status_value = map[string]int32{
+		"ON_TIME": 0,
+		"EARLY":   1,
+		"LATE":    2,
+	}
+)
+
+func (x Adherence_Status) Enum() *Adherence_Status {
+	p := new(Adherence_Status)
+	*p = x
+	return p
+}
+
+func (x Adherence_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Adherence_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_bustracking_proto_enumTypes[1].Descriptor()
+}
+
+func (Adherence_Status) Type() protoreflect.EnumType {
+	return &file_api_proto_bustracking_proto_enumTypes[1]
+}
+
+func (x Adherence_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Adherence_Status.Descriptor instead.
+func (Adherence_Status) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type Zone_Type int32
+
+const (
+	Zone_SERVICE_AREA Zone_Type = 0 // Область обслуживания маршрутов
+	Zone_DEPOT        Zone_Type = 1 // Депо, стоянка
+	Zone_NO_PUBLISH   Zone_Type = 2 // Зона, данные из которой не публикуются
+)
+
+// Enum value maps for Zone_Type.
+var (
+	Zone_Type_name = map[int32]string{
+		0: "SERVICE_AREA",
+		1: "DEPOT",
+		2: "NO_PUBLISH",
+	}
+	Zone_Type_value = map[string]int32{
+		"SERVICE_AREA": 0,
+		"DEPOT":        1,
+		"NO_PUBLISH":   2,
+	}
+)
+
+func (x Zone_Type) Enum() *Zone_Type {
+	p := new(Zone_Type)
+	*p = x
+	return p
+}
+
+func (x Zone_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Zone_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_bustracking_proto_enumTypes[2].Descriptor()
+}
+
+func (Zone_Type) Type() protoreflect.EnumType {
+	return &file_api_proto_bustracking_proto_enumTypes[2]
+}
+
+func (x Zone_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Zone_Type.Descriptor instead.
+func (Zone_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{4, 0}
+}
+
 type Transport_Type int32
 
 const (
@@ -58,11 +215,11 @@ func (x Transport_Type) String() string {
 }
 
 func (Transport_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_proto_bustracking_proto_enumTypes[0].Descriptor()
+	return file_api_proto_bustracking_proto_enumTypes[3].Descriptor()
 }
 
 func (Transport_Type) Type() protoreflect.EnumType {
-	return &file_api_proto_bustracking_proto_enumTypes[0]
+	return &file_api_proto_bustracking_proto_enumTypes[3]
 }
 
 func (x Transport_Type) Number() protoreflect.EnumNumber {
@@ -71,36 +228,2653 @@ func (x Transport_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Transport_Type.Descriptor instead.
 func (Transport_Type) EnumDescriptor() ([]byte, []int) {
-	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{3, 0}
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type Diagnostic_Kind int32
+
+const (
+	Diagnostic_UNKNOWN_UID Diagnostic_Kind = 0 // UID отсутствует в справочнике транспорта
+	Diagnostic_NO_SCHEDULE Diagnostic_Kind = 1 // Для транспорта нет активного расписания
+	Diagnostic_NO_ROUTE    Diagnostic_Kind = 2 // Маршрут из расписания отсутствует в справочнике маршрутов
+)
+
+// Enum value maps for Diagnostic_Kind.
+var (
+	Diagnostic_Kind_name = map[int32]string{
+		0: "UNKNOWN_UID",
+		1: "NO_SCHEDULE",
+		2: "NO_ROUTE",
+	}
+	Diagnostic_Kind_value = map[string]int32{
+		"UNKNOWN_UID": 0,
+		"NO_SCHEDULE": 1,
+		"NO_ROUTE":    2,
+	}
+)
+
+func (x Diagnostic_Kind) Enum() *Diagnostic_Kind {
+	p := new(Diagnostic_Kind)
+	*p = x
+	return p
+}
+
+func (x Diagnostic_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Diagnostic_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_bustracking_proto_enumTypes[4].Descriptor()
+}
+
+func (Diagnostic_Kind) Type() protoreflect.EnumType {
+	return &file_api_proto_bustracking_proto_enumTypes[4]
+}
+
+func (x Diagnostic_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Diagnostic_Kind.Descriptor instead.
+func (Diagnostic_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{10, 0}
+}
+
+type Alert_Kind int32
+
+const (
+	Alert_OFF_ROUTE     Alert_Kind = 0 // Транспорт сошел с маршрута по расписанию
+	Alert_BUNCHING      Alert_Kind = 1 // Интервал до впереди идущего транспорта меньше допустимого
+	Alert_GAP           Alert_Kind = 2 // Интервал до впереди идущего транспорта больше допустимого
+	Alert_LATE_START    Alert_Kind = 3 // Транспорт не вышел на линию к началу смены по расписанию
+	Alert_NOT_REPORTING Alert_Kind = 4 // От транспорта нет данных с начала смены по расписанию
+	Alert_WENT_SILENT   Alert_Kind = 5 // Транспорт перестал передавать данные во время смены
+)
+
+// Enum value maps for Alert_Kind.
+var (
+	Alert_Kind_name = map[int32]string{
+		0: "OFF_ROUTE",
+		1: "BUNCHING",
+		2: "GAP",
+		3: "LATE_START",
+		4: "NOT_REPORTING",
+		5: "WENT_SILENT",
+	}
+	Alert_Kind_value = map[string]int32{
+		"OFF_ROUTE":     0,
+		"BUNCHING":      1,
+		"GAP":           2,
+		"LATE_START":    3,
+		"NOT_REPORTING": 4,
+		"WENT_SILENT":   5,
+	}
+)
+
+func (x Alert_Kind) Enum() *Alert_Kind {
+	p := new(Alert_Kind)
+	*p = x
+	return p
+}
+
+func (x Alert_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Alert_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_bustracking_proto_enumTypes[5].Descriptor()
+}
+
+func (Alert_Kind) Type() protoreflect.EnumType {
+	return &file_api_proto_bustracking_proto_enumTypes[5]
+}
+
+func (x Alert_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Alert_Kind.Descriptor instead.
+func (Alert_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{20, 0}
+}
+
+type Alert_State int32
+
+const (
+	Alert_RAISED  Alert_State = 0 // Событие возникло
+	Alert_CLEARED Alert_State = 1 // Событие завершилось
+)
+
+// Enum value maps for Alert_State.
+var (
+	Alert_State_name = map[int32]string{
+		0: "RAISED",
+		1: "CLEARED",
+	}
+	Alert_State_value = map[string]int32{
+		"RAISED":  0,
+		"CLEARED": 1,
+	}
+)
+
+func (x Alert_State) Enum() *Alert_State {
+	p := new(Alert_State)
+	*p = x
+	return p
+}
+
+func (x Alert_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Alert_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_bustracking_proto_enumTypes[6].Descriptor()
+}
+
+func (Alert_State) Type() protoreflect.EnumType {
+	return &file_api_proto_bustracking_proto_enumTypes[6]
+}
+
+func (x Alert_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Alert_State.Descriptor instead.
+func (Alert_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{20, 1}
+}
+
+type StopEvent_Kind int32
+
+const (
+	StopEvent_ARRIVAL   StopEvent_Kind = 0 // Прибытие на остановку
+	StopEvent_DEPARTURE StopEvent_Kind = 1 // Отправление с остановки
+)
+
+// Enum value maps for StopEvent_Kind.
+var (
+	StopEvent_Kind_name = map[int32]string{
+		0: "ARRIVAL",
+		1: "DEPARTURE",
+	}
+	StopEvent_Kind_value = map[string]int32{
+		"ARRIVAL":   0,
+		"DEPARTURE": 1,
+	}
+)
+
+func (x StopEvent_Kind) Enum() *StopEvent_Kind {
+	p := new(StopEvent_Kind)
+	*p = x
+	return p
+}
+
+func (x StopEvent_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StopEvent_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_bustracking_proto_enumTypes[7].Descriptor()
+}
+
+func (StopEvent_Kind) Type() protoreflect.EnumType {
+	return &file_api_proto_bustracking_proto_enumTypes[7]
+}
+
+func (x StopEvent_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StopEvent_Kind.Descriptor instead.
+func (StopEvent_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{26, 0}
+}
+
+type ClockSkew_Correction int32
+
+const (
+	ClockSkew_NONE       ClockSkew_Correction = 0 // Время трекера не исправляется
+	ClockSkew_AUTO       ClockSkew_Correction = 1 // Поправка по оценке расхождения часов
+	ClockSkew_CONFIGURED ClockSkew_Correction = 2 // Поправка задана в конфигурации
+)
+
+// Enum value maps for ClockSkew_Correction.
+var (
+	ClockSkew_Correction_name = map[int32]string{
+		0: "NONE",
+		1: "AUTO",
+		2: "CONFIGURED",
+	}
+	ClockSkew_Correction_value = map[string]int32{
+		"NONE":       0,
+		"AUTO":       1,
+		"CONFIGURED": 2,
+	}
+)
+
+func (x ClockSkew_Correction) Enum() *ClockSkew_Correction {
+	p := new(ClockSkew_Correction)
+	*p = x
+	return p
+}
+
+func (x ClockSkew_Correction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClockSkew_Correction) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_bustracking_proto_enumTypes[8].Descriptor()
+}
+
+func (ClockSkew_Correction) Type() protoreflect.EnumType {
+	return &file_api_proto_bustracking_proto_enumTypes[8]
+}
+
+func (x ClockSkew_Correction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClockSkew_Correction.Descriptor instead.
+func (ClockSkew_Correction) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{35, 0}
+}
+
+type GPSData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"` // Идентификатор ТС в системе которая ретранслирует gps данные
+	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Speed         uint32                 `protobuf:"varint,4,opt,name=speed,proto3" json:"speed,omitempty"`
+	Course        uint32                 `protobuf:"varint,5,opt,name=course,proto3" json:"course,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+	Receiver      string                 `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"` // Приемник, через который получены данные (wialon_ips, egts, grpc), заполняется сервером
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GPSData) Reset() {
+	*x = GPSData{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GPSData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPSData) ProtoMessage() {}
+
+func (x *GPSData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPSData.ProtoReflect.Descriptor instead.
+func (*GPSData) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GPSData) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *GPSData) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GPSData) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GPSData) GetSpeed() uint32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *GPSData) GetCourse() uint32 {
+	if x != nil {
+		return x.Course
+	}
+	return 0
+}
+
+func (x *GPSData) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *GPSData) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+type BusTrackingInfo struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	GpsData       *GPSData                      `protobuf:"bytes,1,opt,name=gps_data,json=gpsData,proto3" json:"gps_data,omitempty"`
+	Route         *Route                        `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+	Transport     *Transport                    `protobuf:"bytes,3,opt,name=transport,proto3" json:"transport,omitempty"`
+	Schedule      *Schedule                     `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Zones         []*Zone                       `protobuf:"bytes,5,rep,name=zones,proto3" json:"zones,omitempty"`                                                                          // Геозоны, в которых находится транспортное средство
+	RouteMatch    *RouteMatch                   `protobuf:"bytes,6,opt,name=route_match,json=routeMatch,proto3" json:"route_match,omitempty"`                                              // Положение относительно линии маршрута, не заполняется без геометрии маршрута
+	Adherence     *Adherence                    `protobuf:"bytes,7,opt,name=adherence,proto3" json:"adherence,omitempty"`                                                                  // Соблюдение расписания, не заполняется, если рейс расписания не определен
+	ScheduleMatch BusTrackingInfo_ScheduleMatch `protobuf:"varint,8,opt,name=schedule_match,json=scheduleMatch,proto3,enum=BusTrackingInfo_ScheduleMatch" json:"schedule_match,omitempty"` // Способ, которым определено расписание
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BusTrackingInfo) Reset() {
+	*x = BusTrackingInfo{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BusTrackingInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BusTrackingInfo) ProtoMessage() {}
+
+func (x *BusTrackingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BusTrackingInfo.ProtoReflect.Descriptor instead.
+func (*BusTrackingInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BusTrackingInfo) GetGpsData() *GPSData {
+	if x != nil {
+		return x.GpsData
+	}
+	return nil
+}
+
+func (x *BusTrackingInfo) GetRoute() *Route {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *BusTrackingInfo) GetTransport() *Transport {
+	if x != nil {
+		return x.Transport
+	}
+	return nil
+}
+
+func (x *BusTrackingInfo) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *BusTrackingInfo) GetZones() []*Zone {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+func (x *BusTrackingInfo) GetRouteMatch() *RouteMatch {
+	if x != nil {
+		return x.RouteMatch
+	}
+	return nil
+}
+
+func (x *BusTrackingInfo) GetAdherence() *Adherence {
+	if x != nil {
+		return x.Adherence
+	}
+	return nil
+}
+
+func (x *BusTrackingInfo) GetScheduleMatch() BusTrackingInfo_ScheduleMatch {
+	if x != nil {
+		return x.ScheduleMatch
+	}
+	return BusTrackingInfo_EXACT
+}
+
+type Adherence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"` // Рейс расписания, с которым сравнивается движение
+	Deviation     int64                  `protobuf:"varint,2,opt,name=deviation,proto3" json:"deviation,omitempty"`        // Отклонение в секундах: положительное - опоздание, отрицательное - опережение
+	Status        Adherence_Status       `protobuf:"varint,3,opt,name=status,proto3,enum=Adherence_Status" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Adherence) Reset() {
+	*x = Adherence{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Adherence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Adherence) ProtoMessage() {}
+
+func (x *Adherence) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Adherence.ProtoReflect.Descriptor instead.
+func (*Adherence) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Adherence) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *Adherence) GetDeviation() int64 {
+	if x != nil {
+		return x.Deviation
+	}
+	return 0
+}
+
+func (x *Adherence) GetStatus() Adherence_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Adherence_ON_TIME
+}
+
+type RouteMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matched       bool                   `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`    // Отклонение от линии маршрута в допустимых пределах
+	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"` // Ближайшая к транспортному средству точка на линии маршрута
+	Longitude     float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	DistanceAlong float64                `protobuf:"fixed64,4,opt,name=distance_along,json=distanceAlong,proto3" json:"distance_along,omitempty"` // Расстояние от начала маршрута в метрах
+	Offset        float64                `protobuf:"fixed64,5,opt,name=offset,proto3" json:"offset,omitempty"`                                    // Отклонение от линии маршрута в метрах
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteMatch) Reset() {
+	*x = RouteMatch{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteMatch) ProtoMessage() {}
+
+func (x *RouteMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteMatch.ProtoReflect.Descriptor instead.
+func (*RouteMatch) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RouteMatch) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *RouteMatch) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *RouteMatch) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *RouteMatch) GetDistanceAlong() float64 {
+	if x != nil {
+		return x.DistanceAlong
+	}
+	return 0
+}
+
+func (x *RouteMatch) GetOffset() float64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type Zone struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          Zone_Type              `protobuf:"varint,3,opt,name=type,proto3,enum=Zone_Type" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Zone) Reset() {
+	*x = Zone{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Zone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Zone) ProtoMessage() {}
+
+func (x *Zone) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Zone.ProtoReflect.Descriptor instead.
+func (*Zone) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Zone) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Zone) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Zone) GetType() Zone_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Zone_SERVICE_AREA
+}
+
+type Route struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        string                 `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`               // Номер маршрута в расписании
+	Yandex        string                 `protobuf:"bytes,2,opt,name=yandex,proto3" json:"yandex,omitempty"`               // Номер маршрута, отправляемый в "Яндекс.Карты"
+	TwoGis        string                 `protobuf:"bytes,3,opt,name=two_gis,json=twoGis,proto3" json:"two_gis,omitempty"` // Номер маршрута, отправляемый в "2gis"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Route) Reset() {
+	*x = Route{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Route) ProtoMessage() {}
+
+func (x *Route) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Route.ProtoReflect.Descriptor instead.
+func (*Route) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Route) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Route) GetYandex() string {
+	if x != nil {
+		return x.Yandex
+	}
+	return ""
+}
+
+func (x *Route) GetTwoGis() string {
+	if x != nil {
+		return x.TwoGis
+	}
+	return ""
+}
+
+type Transport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                                  // Уникальный идентификатор (uid) транспортного средства в системе из которой идет ретрансляция gps данных
+	StateNumber   string                 `protobuf:"bytes,2,opt,name=state_number,json=stateNumber,proto3" json:"state_number,omitempty"` // Уникальный идентификатор транспортного средства в расписании
+	Type          Transport_Type         `protobuf:"varint,3,opt,name=type,proto3,enum=Transport_Type" json:"type,omitempty"`             // Тип движущегося транспортного средства
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transport) Reset() {
+	*x = Transport{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transport) ProtoMessage() {}
+
+func (x *Transport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transport.ProtoReflect.Descriptor instead.
+func (*Transport) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Transport) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Transport) GetStateNumber() string {
+	if x != nil {
+		return x.StateNumber
+	}
+	return ""
+}
+
+func (x *Transport) GetType() Transport_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Transport_BUS
+}
+
+type Schedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        string                 `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`                              // Номер маршрута, соответствующего Route.number
+	StateNumber   string                 `protobuf:"bytes,2,opt,name=state_number,json=stateNumber,proto3" json:"state_number,omitempty"` // Уникальный идентификатор транспортного средства, соответствующего Transport.state_number
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=From,proto3" json:"From,omitempty"`                                  // Дата и время начала работы транспортного средства на маршруте
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=To,proto3" json:"To,omitempty"`                                      // Дата и время окончания работы транспортного средства на маршруте
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Schedule) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Schedule) GetStateNumber() string {
+	if x != nil {
+		return x.StateNumber
+	}
+	return ""
+}
+
+func (x *Schedule) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *Schedule) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type StreamGPSDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamGPSDataResponse) Reset() {
+	*x = StreamGPSDataResponse{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamGPSDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamGPSDataResponse) ProtoMessage() {}
+
+func (x *StreamGPSDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamGPSDataResponse.ProtoReflect.Descriptor instead.
+func (*StreamGPSDataResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{8}
+}
+
+type StreamBusDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamBusDataRequest) Reset() {
+	*x = StreamBusDataRequest{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamBusDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamBusDataRequest) ProtoMessage() {}
+
+func (x *StreamBusDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamBusDataRequest.ProtoReflect.Descriptor instead.
+func (*StreamBusDataRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{9}
+}
+
+type Diagnostic struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          Diagnostic_Kind        `protobuf:"varint,1,opt,name=kind,proto3,enum=Diagnostic_Kind" json:"kind,omitempty"`
+	Uid           string                 `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`                                      // Идентификатор ТС в системе которая ретранслирует gps данные
+	StateNumber   string                 `protobuf:"bytes,3,opt,name=state_number,json=stateNumber,proto3" json:"state_number,omitempty"`   // Заполняется, если транспорт найден
+	RouteNumber   string                 `protobuf:"bytes,4,opt,name=route_number,json=routeNumber,proto3" json:"route_number,omitempty"`   // Заполняется для NO_ROUTE
+	FirstSeen     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`         // Дата и время первого появления проблемы
+	LastSeen      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`            // Дата и время последнего появления проблемы
+	Count         uint64                 `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`                                 // Количество сообщений с проблемой
+	LastGpsData   *GPSData               `protobuf:"bytes,8,opt,name=last_gps_data,json=lastGpsData,proto3" json:"last_gps_data,omitempty"` // Последние GPS-данные с проблемой
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Diagnostic) Reset() {
+	*x = Diagnostic{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Diagnostic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Diagnostic) ProtoMessage() {}
+
+func (x *Diagnostic) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Diagnostic.ProtoReflect.Descriptor instead.
+func (*Diagnostic) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Diagnostic) GetKind() Diagnostic_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Diagnostic_UNKNOWN_UID
+}
+
+func (x *Diagnostic) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Diagnostic) GetStateNumber() string {
+	if x != nil {
+		return x.StateNumber
+	}
+	return ""
+}
+
+func (x *Diagnostic) GetRouteNumber() string {
+	if x != nil {
+		return x.RouteNumber
+	}
+	return ""
+}
+
+func (x *Diagnostic) GetFirstSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return nil
+}
+
+func (x *Diagnostic) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *Diagnostic) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Diagnostic) GetLastGpsData() *GPSData {
+	if x != nil {
+		return x.LastGpsData
+	}
+	return nil
+}
+
+type ListDiagnosticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kinds         []Diagnostic_Kind      `protobuf:"varint,1,rep,packed,name=kinds,proto3,enum=Diagnostic_Kind" json:"kinds,omitempty"` // Если не задано, возвращаются все виды проблем
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDiagnosticsRequest) Reset() {
+	*x = ListDiagnosticsRequest{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiagnosticsRequest) ProtoMessage() {}
+
+func (x *ListDiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*ListDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListDiagnosticsRequest) GetKinds() []Diagnostic_Kind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+type ListDiagnosticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Diagnostic          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDiagnosticsResponse) Reset() {
+	*x = ListDiagnosticsResponse{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDiagnosticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiagnosticsResponse) ProtoMessage() {}
+
+func (x *ListDiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*ListDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListDiagnosticsResponse) GetItems() []*Diagnostic {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type VehicleState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *BusTrackingInfo       `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`                            // Последние обработанные данные, включая расписание
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Дата и время обработки последних данных сервером
+	Age           *durationpb.Duration   `protobuf:"bytes,3,opt,name=age,proto3" json:"age,omitempty"`                              // Время, прошедшее с момента получения координат
+	Stale         bool                   `protobuf:"varint,4,opt,name=stale,proto3" json:"stale,omitempty"`                         // Данные не обновлялись дольше окна активности
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VehicleState) Reset() {
+	*x = VehicleState{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VehicleState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleState) ProtoMessage() {}
+
+func (x *VehicleState) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehicleState.ProtoReflect.Descriptor instead.
+func (*VehicleState) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VehicleState) GetInfo() *BusTrackingInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *VehicleState) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *VehicleState) GetAge() *durationpb.Duration {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *VehicleState) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+type GetVehicleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Key:
+	//
+	//	*GetVehicleRequest_StateNumber
+	//	*GetVehicleRequest_Uid
+	Key           isGetVehicleRequest_Key `protobuf_oneof:"key"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVehicleRequest) Reset() {
+	*x = GetVehicleRequest{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVehicleRequest) ProtoMessage() {}
+
+func (x *GetVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVehicleRequest.ProtoReflect.Descriptor instead.
+func (*GetVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetVehicleRequest) GetKey() isGetVehicleRequest_Key {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *GetVehicleRequest) GetStateNumber() string {
+	if x != nil {
+		if x, ok := x.Key.(*GetVehicleRequest_StateNumber); ok {
+			return x.StateNumber
+		}
+	}
+	return ""
+}
+
+func (x *GetVehicleRequest) GetUid() string {
+	if x != nil {
+		if x, ok := x.Key.(*GetVehicleRequest_Uid); ok {
+			return x.Uid
+		}
+	}
+	return ""
+}
+
+type isGetVehicleRequest_Key interface {
+	isGetVehicleRequest_Key()
+}
+
+type GetVehicleRequest_StateNumber struct {
+	StateNumber string `protobuf:"bytes,1,opt,name=state_number,json=stateNumber,proto3,oneof"`
+}
+
+type GetVehicleRequest_Uid struct {
+	Uid string `protobuf:"bytes,2,opt,name=uid,proto3,oneof"`
+}
+
+func (*GetVehicleRequest_StateNumber) isGetVehicleRequest_Key() {}
+
+func (*GetVehicleRequest_Uid) isGetVehicleRequest_Key() {}
+
+type ListVehiclesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RouteNumber   string                 `protobuf:"bytes,1,opt,name=route_number,json=routeNumber,proto3" json:"route_number,omitempty"` // Номер маршрута в расписании, если не задан - все маршруты
+	Types         []Transport_Type       `protobuf:"varint,2,rep,packed,name=types,proto3,enum=Transport_Type" json:"types,omitempty"`    // Если не задано - все типы
+	ActiveSince   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=active_since,json=activeSince,proto3" json:"active_since,omitempty"` // Если не задано - все транспортные средства, включая устаревшие
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVehiclesRequest) Reset() {
+	*x = ListVehiclesRequest{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVehiclesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVehiclesRequest) ProtoMessage() {}
+
+func (x *ListVehiclesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVehiclesRequest.ProtoReflect.Descriptor instead.
+func (*ListVehiclesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListVehiclesRequest) GetRouteNumber() string {
+	if x != nil {
+		return x.RouteNumber
+	}
+	return ""
+}
+
+func (x *ListVehiclesRequest) GetTypes() []Transport_Type {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ListVehiclesRequest) GetActiveSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveSince
+	}
+	return nil
+}
+
+type ListVehiclesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*VehicleState        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVehiclesResponse) Reset() {
+	*x = ListVehiclesResponse{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVehiclesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVehiclesResponse) ProtoMessage() {}
+
+func (x *ListVehiclesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVehiclesResponse.ProtoReflect.Descriptor instead.
+func (*ListVehiclesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListVehiclesResponse) GetItems() []*VehicleState {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RouteActivity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Route         *Route                 `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	StateNumbers  []string               `protobuf:"bytes,2,rep,name=state_numbers,json=stateNumbers,proto3" json:"state_numbers,omitempty"` // Транспортные средства на маршруте
+	LastUpdate    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`       // Дата и время последних данных по маршруту
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteActivity) Reset() {
+	*x = RouteActivity{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteActivity) ProtoMessage() {}
+
+func (x *RouteActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteActivity.ProtoReflect.Descriptor instead.
+func (*RouteActivity) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RouteActivity) GetRoute() *Route {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *RouteActivity) GetStateNumbers() []string {
+	if x != nil {
+		return x.StateNumbers
+	}
+	return nil
+}
+
+func (x *RouteActivity) GetLastUpdate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdate
+	}
+	return nil
+}
+
+type ListRoutesActivityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActiveSince   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=active_since,json=activeSince,proto3" json:"active_since,omitempty"` // Если не задано - используется окно активности сервера
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoutesActivityRequest) Reset() {
+	*x = ListRoutesActivityRequest{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoutesActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoutesActivityRequest) ProtoMessage() {}
+
+func (x *ListRoutesActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoutesActivityRequest.ProtoReflect.Descriptor instead.
+func (*ListRoutesActivityRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListRoutesActivityRequest) GetActiveSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveSince
+	}
+	return nil
+}
+
+type ListRoutesActivityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*RouteActivity       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoutesActivityResponse) Reset() {
+	*x = ListRoutesActivityResponse{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoutesActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoutesActivityResponse) ProtoMessage() {}
+
+func (x *ListRoutesActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoutesActivityResponse.ProtoReflect.Descriptor instead.
+func (*ListRoutesActivityResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListRoutesActivityResponse) GetItems() []*RouteActivity {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type Alert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          Alert_Kind             `protobuf:"varint,1,opt,name=kind,proto3,enum=Alert_Kind" json:"kind,omitempty"`
+	State         Alert_State            `protobuf:"varint,2,opt,name=state,proto3,enum=Alert_State" json:"state,omitempty"`
+	StateNumber   string                 `protobuf:"bytes,3,opt,name=state_number,json=stateNumber,proto3" json:"state_number,omitempty"`
+	Uid           string                 `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`                                    // Идентификатор ТС в системе которая ретранслирует gps данные
+	RouteNumber   string                 `protobuf:"bytes,5,opt,name=route_number,json=routeNumber,proto3" json:"route_number,omitempty"` // Маршрут по расписанию
+	Since         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"`                                // Дата и время возникновения события
+	Time          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"`                                  // Дата и время изменения состояния события
+	GpsData       *GPSData               `protobuf:"bytes,8,opt,name=gps_data,json=gpsData,proto3" json:"gps_data,omitempty"`             // GPS-данные, по которым изменилось состояние события
+	Offset        float64                `protobuf:"fixed64,9,opt,name=offset,proto3" json:"offset,omitempty"`                            // Отклонение от линии маршрута в метрах для OFF_ROUTE
+	Headway       *durationpb.Duration   `protobuf:"bytes,10,opt,name=headway,proto3" json:"headway,omitempty"`                           // Интервал до впереди идущего транспорта для BUNCHING и GAP
+	Leader        string                 `protobuf:"bytes,11,opt,name=leader,proto3" json:"leader,omitempty"`                             // Госномер впереди идущего транспорта для BUNCHING и GAP
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Alert) GetKind() Alert_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Alert_OFF_ROUTE
+}
+
+func (x *Alert) GetState() Alert_State {
+	if x != nil {
+		return x.State
+	}
+	return Alert_RAISED
+}
+
+func (x *Alert) GetStateNumber() string {
+	if x != nil {
+		return x.StateNumber
+	}
+	return ""
+}
+
+func (x *Alert) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Alert) GetRouteNumber() string {
+	if x != nil {
+		return x.RouteNumber
+	}
+	return ""
+}
+
+func (x *Alert) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *Alert) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Alert) GetGpsData() *GPSData {
+	if x != nil {
+		return x.GpsData
+	}
+	return nil
+}
+
+func (x *Alert) GetOffset() float64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Alert) GetHeadway() *durationpb.Duration {
+	if x != nil {
+		return x.Headway
+	}
+	return nil
+}
+
+func (x *Alert) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+type StreamAlertsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Kinds          []Alert_Kind           `protobuf:"varint,1,rep,packed,name=kinds,proto3,enum=Alert_Kind" json:"kinds,omitempty"`                  // Если не задано, передаются все виды событий
+	RouteNumber    string                 `protobuf:"bytes,2,opt,name=route_number,json=routeNumber,proto3" json:"route_number,omitempty"`           // Если задано, передаются события только по маршруту
+	IncludeActive  bool                   `protobuf:"varint,3,opt,name=include_active,json=includeActive,proto3" json:"include_active,omitempty"`    // Передать в начале потока события, которые еще не завершились
+	IncludeHistory bool                   `protobuf:"varint,4,opt,name=include_history,json=includeHistory,proto3" json:"include_history,omitempty"` // Передать в начале потока последние изменения состояния событий (не более ALERTS_HISTORY), до активных
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StreamAlertsRequest) Reset() {
+	*x = StreamAlertsRequest{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamAlertsRequest) ProtoMessage() {}
+
+func (x *StreamAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamAlertsRequest.ProtoReflect.Descriptor instead.
+func (*StreamAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *StreamAlertsRequest) GetKinds() []Alert_Kind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *StreamAlertsRequest) GetRouteNumber() string {
+	if x != nil {
+		return x.RouteNumber
+	}
+	return ""
+}
+
+func (x *StreamAlertsRequest) GetIncludeActive() bool {
+	if x != nil {
+		return x.IncludeActive
+	}
+	return false
+}
+
+func (x *StreamAlertsRequest) GetIncludeHistory() bool {
+	if x != nil {
+		return x.IncludeHistory
+	}
+	return false
+}
+
+type Stop struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Sequence      uint32                 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"` // Порядковый номер остановки на маршруте
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Stop) Reset() {
+	*x = Stop{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stop) ProtoMessage() {}
+
+func (x *Stop) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stop.ProtoReflect.Descriptor instead.
+func (*Stop) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Stop) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Stop) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Stop) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Stop) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Stop) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type StopPrediction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StateNumber   string                 `protobuf:"bytes,1,opt,name=state_number,json=stateNumber,proto3" json:"state_number,omitempty"`
+	RouteNumber   string                 `protobuf:"bytes,2,opt,name=route_number,json=routeNumber,proto3" json:"route_number,omitempty"`
+	Stop          *Stop                  `protobuf:"bytes,3,opt,name=stop,proto3" json:"stop,omitempty"`
+	Arrival       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=arrival,proto3" json:"arrival,omitempty"` // Для остановки, на которой находится транспорт, - фактическое время прибытия
+	Departure     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=departure,proto3" json:"departure,omitempty"`
+	AtStop        bool                   `protobuf:"varint,6,opt,name=at_stop,json=atStop,proto3" json:"at_stop,omitempty"` // Транспортное средство находится на остановке
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopPrediction) Reset() {
+	*x = StopPrediction{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopPrediction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopPrediction) ProtoMessage() {}
+
+func (x *StopPrediction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopPrediction.ProtoReflect.Descriptor instead.
+func (*StopPrediction) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StopPrediction) GetStateNumber() string {
+	if x != nil {
+		return x.StateNumber
+	}
+	return ""
+}
+
+func (x *StopPrediction) GetRouteNumber() string {
+	if x != nil {
+		return x.RouteNumber
+	}
+	return ""
+}
+
+func (x *StopPrediction) GetStop() *Stop {
+	if x != nil {
+		return x.Stop
+	}
+	return nil
+}
+
+func (x *StopPrediction) GetArrival() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Arrival
+	}
+	return nil
+}
+
+func (x *StopPrediction) GetDeparture() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Departure
+	}
+	return nil
+}
+
+func (x *StopPrediction) GetAtStop() bool {
+	if x != nil {
+		return x.AtStop
+	}
+	return false
+}
+
+type ListStopPredictionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StopId        string                 `protobuf:"bytes,1,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`                // Если задано, возвращаются прогнозы только по остановке
+	RouteNumber   string                 `protobuf:"bytes,2,opt,name=route_number,json=routeNumber,proto3" json:"route_number,omitempty"` // Если задано, возвращаются прогнозы только по маршруту
+	StateNumber   string                 `protobuf:"bytes,3,opt,name=state_number,json=stateNumber,proto3" json:"state_number,omitempty"` // Если задано, возвращаются прогнозы только по транспортному средству
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStopPredictionsRequest) Reset() {
+	*x = ListStopPredictionsRequest{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStopPredictionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStopPredictionsRequest) ProtoMessage() {}
+
+func (x *ListStopPredictionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStopPredictionsRequest.ProtoReflect.Descriptor instead.
+func (*ListStopPredictionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListStopPredictionsRequest) GetStopId() string {
+	if x != nil {
+		return x.StopId
+	}
+	return ""
+}
+
+func (x *ListStopPredictionsRequest) GetRouteNumber() string {
+	if x != nil {
+		return x.RouteNumber
+	}
+	return ""
+}
+
+func (x *ListStopPredictionsRequest) GetStateNumber() string {
+	if x != nil {
+		return x.StateNumber
+	}
+	return ""
+}
+
+type ListStopPredictionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*StopPrediction      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // Упорядочены по времени прибытия
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStopPredictionsResponse) Reset() {
+	*x = ListStopPredictionsResponse{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStopPredictionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStopPredictionsResponse) ProtoMessage() {}
+
+func (x *ListStopPredictionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStopPredictionsResponse.ProtoReflect.Descriptor instead.
+func (*ListStopPredictionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListStopPredictionsResponse) GetItems() []*StopPrediction {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type StopEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          StopEvent_Kind         `protobuf:"varint,1,opt,name=kind,proto3,enum=StopEvent_Kind" json:"kind,omitempty"`
+	StateNumber   string                 `protobuf:"bytes,2,opt,name=state_number,json=stateNumber,proto3" json:"state_number,omitempty"`
+	Uid           string                 `protobuf:"bytes,3,opt,name=uid,proto3" json:"uid,omitempty"` // Идентификатор ТС в системе которая ретранслирует gps данные
+	RouteNumber   string                 `protobuf:"bytes,4,opt,name=route_number,json=routeNumber,proto3" json:"route_number,omitempty"`
+	Stop          *Stop                  `protobuf:"bytes,5,opt,name=stop,proto3" json:"stop,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopEvent) Reset() {
+	*x = StopEvent{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopEvent) ProtoMessage() {}
+
+func (x *StopEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopEvent.ProtoReflect.Descriptor instead.
+func (*StopEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StopEvent) GetKind() StopEvent_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return StopEvent_ARRIVAL
+}
+
+func (x *StopEvent) GetStateNumber() string {
+	if x != nil {
+		return x.StateNumber
+	}
+	return ""
+}
+
+func (x *StopEvent) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *StopEvent) GetRouteNumber() string {
+	if x != nil {
+		return x.RouteNumber
+	}
+	return ""
+}
+
+func (x *StopEvent) GetStop() *Stop {
+	if x != nil {
+		return x.Stop
+	}
+	return nil
+}
+
+func (x *StopEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type StreamStopEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StopId        string                 `protobuf:"bytes,1,opt,name=stop_id,json=stopId,proto3" json:"stop_id,omitempty"`                // Если задано, передаются события только по остановке
+	RouteNumber   string                 `protobuf:"bytes,2,opt,name=route_number,json=routeNumber,proto3" json:"route_number,omitempty"` // Если задано, передаются события только по маршруту
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamStopEventsRequest) Reset() {
+	*x = StreamStopEventsRequest{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamStopEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamStopEventsRequest) ProtoMessage() {}
+
+func (x *StreamStopEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamStopEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamStopEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StreamStopEventsRequest) GetStopId() string {
+	if x != nil {
+		return x.StopId
+	}
+	return ""
+}
+
+func (x *StreamStopEventsRequest) GetRouteNumber() string {
+	if x != nil {
+		return x.RouteNumber
+	}
+	return ""
+}
+
+type VehicleHeadway struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StateNumber   string                 `protobuf:"bytes,1,opt,name=state_number,json=stateNumber,proto3" json:"state_number,omitempty"`
+	Leader        string                 `protobuf:"bytes,2,opt,name=leader,proto3" json:"leader,omitempty"`                                      // Госномер впереди идущего транспорта, пусто для первого
+	DistanceAlong float64                `protobuf:"fixed64,3,opt,name=distance_along,json=distanceAlong,proto3" json:"distance_along,omitempty"` // Расстояние от начала маршрута в метрах
+	Headway       *durationpb.Duration   `protobuf:"bytes,4,opt,name=headway,proto3" json:"headway,omitempty"`                                    // Не заполняется, если интервал не вычислен
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VehicleHeadway) Reset() {
+	*x = VehicleHeadway{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VehicleHeadway) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleHeadway) ProtoMessage() {}
+
+func (x *VehicleHeadway) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehicleHeadway.ProtoReflect.Descriptor instead.
+func (*VehicleHeadway) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *VehicleHeadway) GetStateNumber() string {
+	if x != nil {
+		return x.StateNumber
+	}
+	return ""
+}
+
+func (x *VehicleHeadway) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+func (x *VehicleHeadway) GetDistanceAlong() float64 {
+	if x != nil {
+		return x.DistanceAlong
+	}
+	return 0
+}
+
+func (x *VehicleHeadway) GetHeadway() *durationpb.Duration {
+	if x != nil {
+		return x.Headway
+	}
+	return nil
+}
+
+type RouteHeadway struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RouteNumber    string                 `protobuf:"bytes,1,opt,name=route_number,json=routeNumber,proto3" json:"route_number,omitempty"`
+	Planned        *durationpb.Duration   `protobuf:"bytes,2,opt,name=planned,proto3" json:"planned,omitempty"`
+	Vehicles       []*VehicleHeadway      `protobuf:"bytes,3,rep,name=vehicles,proto3" json:"vehicles,omitempty"` // В порядке убывания расстояния от начала маршрута
+	Mean           *durationpb.Duration   `protobuf:"bytes,4,opt,name=mean,proto3" json:"mean,omitempty"`
+	Min            *durationpb.Duration   `protobuf:"bytes,5,opt,name=min,proto3" json:"min,omitempty"`
+	Max            *durationpb.Duration   `protobuf:"bytes,6,opt,name=max,proto3" json:"max,omitempty"`
+	BunchingEvents uint64                 `protobuf:"varint,7,opt,name=bunching_events,json=bunchingEvents,proto3" json:"bunching_events,omitempty"` // Количество событий сближения с момента запуска
+	GapEvents      uint64                 `protobuf:"varint,8,opt,name=gap_events,json=gapEvents,proto3" json:"gap_events,omitempty"`                // Количество событий разрыва с момента запуска
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RouteHeadway) Reset() {
+	*x = RouteHeadway{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteHeadway) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteHeadway) ProtoMessage() {}
+
+func (x *RouteHeadway) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteHeadway.ProtoReflect.Descriptor instead.
+func (*RouteHeadway) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RouteHeadway) GetRouteNumber() string {
+	if x != nil {
+		return x.RouteNumber
+	}
+	return ""
+}
+
+func (x *RouteHeadway) GetPlanned() *durationpb.Duration {
+	if x != nil {
+		return x.Planned
+	}
+	return nil
+}
+
+func (x *RouteHeadway) GetVehicles() []*VehicleHeadway {
+	if x != nil {
+		return x.Vehicles
+	}
+	return nil
+}
+
+func (x *RouteHeadway) GetMean() *durationpb.Duration {
+	if x != nil {
+		return x.Mean
+	}
+	return nil
+}
+
+func (x *RouteHeadway) GetMin() *durationpb.Duration {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *RouteHeadway) GetMax() *durationpb.Duration {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+func (x *RouteHeadway) GetBunchingEvents() uint64 {
+	if x != nil {
+		return x.BunchingEvents
+	}
+	return 0
+}
+
+func (x *RouteHeadway) GetGapEvents() uint64 {
+	if x != nil {
+		return x.GapEvents
+	}
+	return 0
+}
+
+type ListHeadwaysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RouteNumber   string                 `protobuf:"bytes,1,opt,name=route_number,json=routeNumber,proto3" json:"route_number,omitempty"` // Если не задано, возвращаются все маршруты
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHeadwaysRequest) Reset() {
+	*x = ListHeadwaysRequest{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHeadwaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHeadwaysRequest) ProtoMessage() {}
+
+func (x *ListHeadwaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHeadwaysRequest.ProtoReflect.Descriptor instead.
+func (*ListHeadwaysRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListHeadwaysRequest) GetRouteNumber() string {
+	if x != nil {
+		return x.RouteNumber
+	}
+	return ""
+}
+
+type ListHeadwaysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*RouteHeadway        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHeadwaysResponse) Reset() {
+	*x = ListHeadwaysResponse{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHeadwaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHeadwaysResponse) ProtoMessage() {}
+
+func (x *ListHeadwaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHeadwaysResponse.ProtoReflect.Descriptor instead.
+func (*ListHeadwaysResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListHeadwaysResponse) GetItems() []*RouteHeadway {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type MissingVehicle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StateNumber   string                 `protobuf:"bytes,1,opt,name=state_number,json=stateNumber,proto3" json:"state_number,omitempty"`
+	RouteNumber   string                 `protobuf:"bytes,2,opt,name=route_number,json=routeNumber,proto3" json:"route_number,omitempty"`    // Маршрут по расписанию
+	ScheduleFrom  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=schedule_from,json=scheduleFrom,proto3" json:"schedule_from,omitempty"` // Начало смены по расписанию
+	ScheduleTo    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=schedule_to,json=scheduleTo,proto3" json:"schedule_to,omitempty"`       // Окончание смены по расписанию
+	Kind          Alert_Kind             `protobuf:"varint,5,opt,name=kind,proto3,enum=Alert_Kind" json:"kind,omitempty"`                    // LATE_START, NOT_REPORTING или WENT_SILENT
+	LastSeen      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`             // Не заполняется, если данных от транспорта не было
+	Since         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=since,proto3" json:"since,omitempty"`                                   // Дата и время, с которого транспорт считается отсутствующим
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MissingVehicle) Reset() {
+	*x = MissingVehicle{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MissingVehicle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissingVehicle) ProtoMessage() {}
+
+func (x *MissingVehicle) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissingVehicle.ProtoReflect.Descriptor instead.
+func (*MissingVehicle) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MissingVehicle) GetStateNumber() string {
+	if x != nil {
+		return x.StateNumber
+	}
+	return ""
+}
+
+func (x *MissingVehicle) GetRouteNumber() string {
+	if x != nil {
+		return x.RouteNumber
+	}
+	return ""
+}
+
+func (x *MissingVehicle) GetScheduleFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduleFrom
+	}
+	return nil
+}
+
+func (x *MissingVehicle) GetScheduleTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduleTo
+	}
+	return nil
+}
+
+func (x *MissingVehicle) GetKind() Alert_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Alert_OFF_ROUTE
+}
+
+func (x *MissingVehicle) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *MissingVehicle) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+type ListMissingVehiclesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kinds         []Alert_Kind           `protobuf:"varint,1,rep,packed,name=kinds,proto3,enum=Alert_Kind" json:"kinds,omitempty"`        // Если не задано, возвращаются все виды
+	RouteNumber   string                 `protobuf:"bytes,2,opt,name=route_number,json=routeNumber,proto3" json:"route_number,omitempty"` // Если задано, возвращается транспорт только по маршруту
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMissingVehiclesRequest) Reset() {
+	*x = ListMissingVehiclesRequest{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMissingVehiclesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMissingVehiclesRequest) ProtoMessage() {}
+
+func (x *ListMissingVehiclesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMissingVehiclesRequest.ProtoReflect.Descriptor instead.
+func (*ListMissingVehiclesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListMissingVehiclesRequest) GetKinds() []Alert_Kind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *ListMissingVehiclesRequest) GetRouteNumber() string {
+	if x != nil {
+		return x.RouteNumber
+	}
+	return ""
+}
+
+type ListMissingVehiclesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*MissingVehicle      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMissingVehiclesResponse) Reset() {
+	*x = ListMissingVehiclesResponse{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMissingVehiclesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMissingVehiclesResponse) ProtoMessage() {}
+
+func (x *ListMissingVehiclesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMissingVehiclesResponse.ProtoReflect.Descriptor instead.
+func (*ListMissingVehiclesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListMissingVehiclesResponse) GetItems() []*MissingVehicle {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ClockSkew struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Samples       int32                  `protobuf:"varint,2,opt,name=samples,proto3" json:"samples,omitempty"`               // Количество сообщений в окне оценки
+	Skew          *durationpb.Duration   `protobuf:"bytes,3,opt,name=skew,proto3" json:"skew,omitempty"`                      // Оценка опережения часов трекера, отрицательная при отставании
+	MinSkew       *durationpb.Duration   `protobuf:"bytes,4,opt,name=min_skew,json=minSkew,proto3" json:"min_skew,omitempty"` // Наименьшее расхождение времени сообщения и времени получения в окне
+	MaxSkew       *durationpb.Duration   `protobuf:"bytes,5,opt,name=max_skew,json=maxSkew,proto3" json:"max_skew,omitempty"` // Наибольшее расхождение времени сообщения и времени получения в окне
+	Offset        *durationpb.Duration   `protobuf:"bytes,6,opt,name=offset,proto3" json:"offset,omitempty"`                  // Поправка, прибавляемая ко времени сообщений
+	Correction    ClockSkew_Correction   `protobuf:"varint,7,opt,name=correction,proto3,enum=ClockSkew_Correction" json:"correction,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Дата и время получения последнего сообщения
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClockSkew) Reset() {
+	*x = ClockSkew{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClockSkew) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClockSkew) ProtoMessage() {}
+
+func (x *ClockSkew) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClockSkew.ProtoReflect.Descriptor instead.
+func (*ClockSkew) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ClockSkew) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ClockSkew) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *ClockSkew) GetSkew() *durationpb.Duration {
+	if x != nil {
+		return x.Skew
+	}
+	return nil
+}
+
+func (x *ClockSkew) GetMinSkew() *durationpb.Duration {
+	if x != nil {
+		return x.MinSkew
+	}
+	return nil
+}
+
+func (x *ClockSkew) GetMaxSkew() *durationpb.Duration {
+	if x != nil {
+		return x.MaxSkew
+	}
+	return nil
+}
+
+func (x *ClockSkew) GetOffset() *durationpb.Duration {
+	if x != nil {
+		return x.Offset
+	}
+	return nil
+}
+
+func (x *ClockSkew) GetCorrection() ClockSkew_Correction {
+	if x != nil {
+		return x.Correction
+	}
+	return ClockSkew_NONE
+}
+
+func (x *ClockSkew) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
 }
 
-type GPSData struct {
+type ListClockSkewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"` // Идентификатор ТС в системе которая ретранслирует gps данные
-	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude     float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	Speed         uint32                 `protobuf:"varint,4,opt,name=speed,proto3" json:"speed,omitempty"`
-	Course        uint32                 `protobuf:"varint,5,opt,name=course,proto3" json:"course,omitempty"`
-	Time          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"` // Если задано, возвращается оценка только для трекера
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GPSData) Reset() {
-	*x = GPSData{}
-	mi := &file_api_proto_bustracking_proto_msgTypes[0]
+func (x *ListClockSkewRequest) Reset() {
+	*x = ListClockSkewRequest{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GPSData) String() string {
+func (x *ListClockSkewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GPSData) ProtoMessage() {}
+func (*ListClockSkewRequest) ProtoMessage() {}
 
-func (x *GPSData) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_bustracking_proto_msgTypes[0]
+func (x *ListClockSkewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -111,78 +2885,87 @@ func (x *GPSData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GPSData.ProtoReflect.Descriptor instead.
-func (*GPSData) Descriptor() ([]byte, []int) {
-	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ListClockSkewRequest.ProtoReflect.Descriptor instead.
+func (*ListClockSkewRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GPSData) GetUid() string {
+func (x *ListClockSkewRequest) GetUid() string {
 	if x != nil {
 		return x.Uid
 	}
 	return ""
 }
 
-func (x *GPSData) GetLatitude() float64 {
-	if x != nil {
-		return x.Latitude
-	}
-	return 0
+type ListClockSkewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ClockSkew           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GPSData) GetLongitude() float64 {
-	if x != nil {
-		return x.Longitude
-	}
-	return 0
+func (x *ListClockSkewResponse) Reset() {
+	*x = ListClockSkewResponse{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GPSData) GetSpeed() uint32 {
-	if x != nil {
-		return x.Speed
-	}
-	return 0
+func (x *ListClockSkewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GPSData) GetCourse() uint32 {
+func (*ListClockSkewResponse) ProtoMessage() {}
+
+func (x *ListClockSkewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[37]
 	if x != nil {
-		return x.Course
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GPSData) GetTime() *timestamppb.Timestamp {
+// Deprecated: Use ListClockSkewResponse.ProtoReflect.Descriptor instead.
+func (*ListClockSkewResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListClockSkewResponse) GetItems() []*ClockSkew {
 	if x != nil {
-		return x.Time
+		return x.Items
 	}
 	return nil
 }
 
-type BusTrackingInfo struct {
+type TransportBinding struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GpsData       *GPSData               `protobuf:"bytes,1,opt,name=gps_data,json=gpsData,proto3" json:"gps_data,omitempty"`
-	Route         *Route                 `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
-	Transport     *Transport             `protobuf:"bytes,3,opt,name=transport,proto3" json:"transport,omitempty"`
-	Schedule      *Schedule              `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Transport     *Transport             `protobuf:"bytes,1,opt,name=transport,proto3" json:"transport,omitempty"`
+	Priority      int32                  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`                   // Приоритет трекера на транспорте, меньшее значение - основной трекер
+	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"` // Не заполняется, если начало не ограничено
+	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`       // Не входит в период действия; не заполняется, если окончание не ограничено
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BusTrackingInfo) Reset() {
-	*x = BusTrackingInfo{}
-	mi := &file_api_proto_bustracking_proto_msgTypes[1]
+func (x *TransportBinding) Reset() {
+	*x = TransportBinding{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BusTrackingInfo) String() string {
+func (x *TransportBinding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BusTrackingInfo) ProtoMessage() {}
+func (*TransportBinding) ProtoMessage() {}
 
-func (x *BusTrackingInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_bustracking_proto_msgTypes[1]
+func (x *TransportBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -193,63 +2976,62 @@ func (x *BusTrackingInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BusTrackingInfo.ProtoReflect.Descriptor instead.
-func (*BusTrackingInfo) Descriptor() ([]byte, []int) {
-	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use TransportBinding.ProtoReflect.Descriptor instead.
+func (*TransportBinding) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *BusTrackingInfo) GetGpsData() *GPSData {
+func (x *TransportBinding) GetTransport() *Transport {
 	if x != nil {
-		return x.GpsData
+		return x.Transport
 	}
 	return nil
 }
 
-func (x *BusTrackingInfo) GetRoute() *Route {
+func (x *TransportBinding) GetPriority() int32 {
 	if x != nil {
-		return x.Route
+		return x.Priority
 	}
-	return nil
+	return 0
 }
 
-func (x *BusTrackingInfo) GetTransport() *Transport {
+func (x *TransportBinding) GetValidFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Transport
+		return x.ValidFrom
 	}
 	return nil
 }
 
-func (x *BusTrackingInfo) GetSchedule() *Schedule {
+func (x *TransportBinding) GetValidTo() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Schedule
+		return x.ValidTo
 	}
 	return nil
 }
 
-type Route struct {
+type ListTransportHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Number        string                 `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`               // Номер маршрута в расписании
-	Yandex        string                 `protobuf:"bytes,2,opt,name=yandex,proto3" json:"yandex,omitempty"`               // Номер маршрута, отправляемый в "Яндекс.Карты"
-	TwoGis        string                 `protobuf:"bytes,3,opt,name=two_gis,json=twoGis,proto3" json:"two_gis,omitempty"` // Номер маршрута, отправляемый в "2gis"
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`                                    // Если задано, возвращаются привязки только трекера
+	StateNumber   string                 `protobuf:"bytes,2,opt,name=state_number,json=stateNumber,proto3" json:"state_number,omitempty"` // Если задано, возвращаются привязки только транспорта
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Route) Reset() {
-	*x = Route{}
-	mi := &file_api_proto_bustracking_proto_msgTypes[2]
+func (x *ListTransportHistoryRequest) Reset() {
+	*x = ListTransportHistoryRequest{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Route) String() string {
+func (x *ListTransportHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Route) ProtoMessage() {}
+func (*ListTransportHistoryRequest) ProtoMessage() {}
 
-func (x *Route) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_bustracking_proto_msgTypes[2]
+func (x *ListTransportHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -260,56 +3042,95 @@ func (x *Route) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Route.ProtoReflect.Descriptor instead.
-func (*Route) Descriptor() ([]byte, []int) {
-	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ListTransportHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListTransportHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *Route) GetNumber() string {
+func (x *ListTransportHistoryRequest) GetUid() string {
 	if x != nil {
-		return x.Number
+		return x.Uid
 	}
 	return ""
 }
 
-func (x *Route) GetYandex() string {
+func (x *ListTransportHistoryRequest) GetStateNumber() string {
 	if x != nil {
-		return x.Yandex
+		return x.StateNumber
 	}
 	return ""
 }
 
-func (x *Route) GetTwoGis() string {
+type ListTransportHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*TransportBinding    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // В порядке госномера и начала действия
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransportHistoryResponse) Reset() {
+	*x = ListTransportHistoryResponse{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransportHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransportHistoryResponse) ProtoMessage() {}
+
+func (x *ListTransportHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[40]
 	if x != nil {
-		return x.TwoGis
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type Transport struct {
+// Deprecated: Use ListTransportHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListTransportHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListTransportHistoryResponse) GetItems() []*TransportBinding {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ScheduleOverride struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                                  // Уникальный идентификатор (uid) транспортного средства в системе из которой идет ретрансляция gps данных
-	StateNumber   string                 `protobuf:"bytes,2,opt,name=state_number,json=stateNumber,proto3" json:"state_number,omitempty"` // Уникальный идентификатор транспортного средства в расписании
-	Type          Transport_Type         `protobuf:"varint,3,opt,name=type,proto3,enum=Transport_Type" json:"type,omitempty"`             // Тип движущегося транспортного средства
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Schedule      *Schedule              `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"` // Маршрут, транспорт и период действия назначения
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // Диспетчер, создавший назначение
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Transport) Reset() {
-	*x = Transport{}
-	mi := &file_api_proto_bustracking_proto_msgTypes[3]
+func (x *ScheduleOverride) Reset() {
+	*x = ScheduleOverride{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transport) String() string {
+func (x *ScheduleOverride) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transport) ProtoMessage() {}
+func (*ScheduleOverride) ProtoMessage() {}
 
-func (x *Transport) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_bustracking_proto_msgTypes[3]
+func (x *ScheduleOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -320,57 +3141,72 @@ func (x *Transport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transport.ProtoReflect.Descriptor instead.
-func (*Transport) Descriptor() ([]byte, []int) {
-	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ScheduleOverride.ProtoReflect.Descriptor instead.
+func (*ScheduleOverride) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *Transport) GetUuid() string {
+func (x *ScheduleOverride) GetId() string {
 	if x != nil {
-		return x.Uuid
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Transport) GetStateNumber() string {
+func (x *ScheduleOverride) GetSchedule() *Schedule {
 	if x != nil {
-		return x.StateNumber
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *ScheduleOverride) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Transport) GetType() Transport_Type {
+func (x *ScheduleOverride) GetCreatedBy() string {
 	if x != nil {
-		return x.Type
+		return x.CreatedBy
 	}
-	return Transport_BUS
+	return ""
 }
 
-type Schedule struct {
+func (x *ScheduleOverride) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateOverrideRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Number        string                 `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`                              // Номер маршрута, соответствующего Route.number
-	StateNumber   string                 `protobuf:"bytes,2,opt,name=state_number,json=stateNumber,proto3" json:"state_number,omitempty"` // Уникальный идентификатор транспортного средства, соответствующего Transport.state_number
-	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=From,proto3" json:"From,omitempty"`                                  // Дата и время начала работы транспортного средства на маршруте
-	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=To,proto3" json:"To,omitempty"`                                      // Дата и время окончания работы транспортного средства на маршруте
+	StateNumber   string                 `protobuf:"bytes,1,opt,name=state_number,json=stateNumber,proto3" json:"state_number,omitempty"`
+	RouteNumber   string                 `protobuf:"bytes,2,opt,name=route_number,json=routeNumber,proto3" json:"route_number,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"` // Если не задано, назначение действует с текущего момента
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Schedule) Reset() {
-	*x = Schedule{}
-	mi := &file_api_proto_bustracking_proto_msgTypes[4]
+func (x *CreateOverrideRequest) Reset() {
+	*x = CreateOverrideRequest{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Schedule) String() string {
+func (x *CreateOverrideRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Schedule) ProtoMessage() {}
+func (*CreateOverrideRequest) ProtoMessage() {}
 
-func (x *Schedule) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_bustracking_proto_msgTypes[4]
+func (x *CreateOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -381,60 +3217,68 @@ func (x *Schedule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
-func (*Schedule) Descriptor() ([]byte, []int) {
-	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CreateOverrideRequest.ProtoReflect.Descriptor instead.
+func (*CreateOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *Schedule) GetNumber() string {
+func (x *CreateOverrideRequest) GetStateNumber() string {
 	if x != nil {
-		return x.Number
+		return x.StateNumber
 	}
 	return ""
 }
 
-func (x *Schedule) GetStateNumber() string {
+func (x *CreateOverrideRequest) GetRouteNumber() string {
 	if x != nil {
-		return x.StateNumber
+		return x.RouteNumber
 	}
 	return ""
 }
 
-func (x *Schedule) GetFrom() *timestamppb.Timestamp {
+func (x *CreateOverrideRequest) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
 		return x.From
 	}
 	return nil
 }
 
-func (x *Schedule) GetTo() *timestamppb.Timestamp {
+func (x *CreateOverrideRequest) GetTo() *timestamppb.Timestamp {
 	if x != nil {
 		return x.To
 	}
 	return nil
 }
 
-type StreamGPSDataResponse struct {
+func (x *CreateOverrideRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListOverridesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	StateNumber   string                 `protobuf:"bytes,1,opt,name=state_number,json=stateNumber,proto3" json:"state_number,omitempty"` // Если задано, возвращаются назначения только этого транспорта
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamGPSDataResponse) Reset() {
-	*x = StreamGPSDataResponse{}
-	mi := &file_api_proto_bustracking_proto_msgTypes[5]
+func (x *ListOverridesRequest) Reset() {
+	*x = ListOverridesRequest{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamGPSDataResponse) String() string {
+func (x *ListOverridesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamGPSDataResponse) ProtoMessage() {}
+func (*ListOverridesRequest) ProtoMessage() {}
 
-func (x *StreamGPSDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_bustracking_proto_msgTypes[5]
+func (x *ListOverridesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,32 +3289,40 @@ func (x *StreamGPSDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamGPSDataResponse.ProtoReflect.Descriptor instead.
-func (*StreamGPSDataResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListOverridesRequest.ProtoReflect.Descriptor instead.
+func (*ListOverridesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{43}
 }
 
-type StreamBusDataRequest struct {
+func (x *ListOverridesRequest) GetStateNumber() string {
+	if x != nil {
+		return x.StateNumber
+	}
+	return ""
+}
+
+type ListOverridesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ScheduleOverride    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamBusDataRequest) Reset() {
-	*x = StreamBusDataRequest{}
-	mi := &file_api_proto_bustracking_proto_msgTypes[6]
+func (x *ListOverridesResponse) Reset() {
+	*x = ListOverridesResponse{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamBusDataRequest) String() string {
+func (x *ListOverridesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamBusDataRequest) ProtoMessage() {}
+func (*ListOverridesResponse) ProtoMessage() {}
 
-func (x *StreamBusDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_bustracking_proto_msgTypes[6]
+func (x *ListOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -481,18 +3333,71 @@ func (x *StreamBusDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamBusDataRequest.ProtoReflect.Descriptor instead.
-func (*StreamBusDataRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListOverridesResponse.ProtoReflect.Descriptor instead.
+func (*ListOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListOverridesResponse) GetItems() []*ScheduleOverride {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DeleteOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOverrideRequest) Reset() {
+	*x = DeleteOverrideRequest{}
+	mi := &file_api_proto_bustracking_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOverrideRequest) ProtoMessage() {}
+
+func (x *DeleteOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_bustracking_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOverrideRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_bustracking_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeleteOverrideRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
 var File_api_proto_bustracking_proto protoreflect.FileDescriptor
 
 var file_api_proto_bustracking_proto_rawDesc = string([]byte{
 	0x0a, 0x1b, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x75, 0x73, 0x74,
-	0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcf,
 	0x01, 0x0a, 0x07, 0x47, 0x50, 0x53, 0x44, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
 	0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
@@ -504,59 +3409,515 @@ var file_api_proto_bustracking_proto_rawDesc = string([]byte{
 	0x75, 0x72, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
-	0x74, 0x69, 0x6d, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x0f, 0x42, 0x75, 0x73, 0x54, 0x72, 0x61, 0x63,
-	0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x08, 0x67, 0x70, 0x73, 0x5f,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x47, 0x50, 0x53,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x67, 0x70, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a,
-	0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x09, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x50, 0x0a, 0x05,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a,
-	0x06, 0x79, 0x61, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x79,
-	0x61, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x77, 0x6f, 0x5f, 0x67, 0x69, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x77, 0x6f, 0x47, 0x69, 0x73, 0x22, 0xa2,
-	0x01, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
+	0x22, 0xd0, 0x03, 0x0a, 0x0f, 0x42, 0x75, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x08, 0x67, 0x70, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x47, 0x50, 0x53, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x07, 0x67, 0x70, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x05, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x25, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x05, 0x7a, 0x6f, 0x6e, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x05,
+	0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x28, 0x0a, 0x09, 0x61, 0x64, 0x68, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x64, 0x68, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x09, 0x61, 0x64, 0x68, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x45, 0x0a,
+	0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x42, 0x75, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x22, 0x6d, 0x0a, 0x0d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00,
+	0x12, 0x0d, 0x0a, 0x09, 0x50, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x01, 0x12,
+	0x0c, 0x0a, 0x08, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x45, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x13, 0x0a,
+	0x0f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54,
+	0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x52, 0x4f,
+	0x55, 0x54, 0x45, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x56, 0x45, 0x52, 0x52, 0x49, 0x44,
+	0x45, 0x10, 0x05, 0x22, 0x99, 0x01, 0x0a, 0x09, 0x41, 0x64, 0x68, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65,
+	0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64,
+	0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x41, 0x64, 0x68, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x2a, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a,
+	0x07, 0x4f, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x41,
+	0x52, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x41, 0x54, 0x45, 0x10, 0x02, 0x22,
+	0x9f, 0x01, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61,
+	0x6c, 0x6f, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x6f, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x22, 0x7f, 0x0a, 0x04, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x5a, 0x6f,
+	0x6e, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x33, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45,
+	0x5f, 0x41, 0x52, 0x45, 0x41, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x50, 0x4f, 0x54,
+	0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48,
+	0x10, 0x02, 0x22, 0x50, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x79, 0x61, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x79, 0x61, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x77, 0x6f, 0x5f, 0x67, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x77,
+	0x6f, 0x47, 0x69, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x39,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x55, 0x53, 0x10, 0x00, 0x12,
+	0x0e, 0x0a, 0x0a, 0x54, 0x52, 0x4f, 0x4c, 0x4c, 0x45, 0x59, 0x42, 0x55, 0x53, 0x10, 0x01, 0x12,
+	0x0b, 0x0a, 0x07, 0x54, 0x52, 0x41, 0x4d, 0x57, 0x41, 0x59, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07,
+	0x4d, 0x49, 0x4e, 0x49, 0x42, 0x55, 0x53, 0x10, 0x03, 0x22, 0xa1, 0x01, 0x0a, 0x08, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x2e, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x46, 0x72, 0x6f,
+	0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x54, 0x6f, 0x22, 0x17, 0x0a,
+	0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x47, 0x50, 0x53, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x42, 0x75, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xfa,
+	0x02, 0x0a, 0x0a, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x12, 0x24, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x44, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x37, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73,
+	0x65, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x67, 0x70,
+	0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x47,
+	0x50, 0x53, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x47, 0x70, 0x73, 0x44,
+	0x61, 0x74, 0x61, 0x22, 0x36, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0f, 0x0a, 0x0b, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x55, 0x49, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
+	0x4e, 0x4f, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a,
+	0x08, 0x4e, 0x4f, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x10, 0x02, 0x22, 0x40, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x22, 0x3c, 0x0a,
+	0x17, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x0c,
+	0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x04,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x42, 0x75, 0x73,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2b, 0x0a,
+	0x03, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65,
+	0x22, 0x53, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x03, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x75, 0x69, 0x64, 0x42, 0x05,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x9e, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65,
+	0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x25, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x0f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x3b, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65,
+	0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x0d, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x5a, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x69, 0x6e, 0x63,
+	0x65, 0x22, 0x42, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x24, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x94, 0x04, 0x0a, 0x05, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12,
+	0x1f, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x22, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0c, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x05,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x2e,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x23,
+	0x0a, 0x08, 0x67, 0x70, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x08, 0x2e, 0x47, 0x50, 0x53, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x67, 0x70, 0x73, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x68,
+	0x65, 0x61, 0x64, 0x77, 0x61, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x77, 0x61, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x60, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64,
+	0x12, 0x0d, 0x0a, 0x09, 0x4f, 0x46, 0x46, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x42, 0x55, 0x4e, 0x43, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x07, 0x0a,
+	0x03, 0x47, 0x41, 0x50, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x41, 0x54, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x52, 0x54, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45,
+	0x50, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x57, 0x45, 0x4e,
+	0x54, 0x5f, 0x53, 0x49, 0x4c, 0x45, 0x4e, 0x54, 0x10, 0x05, 0x22, 0x20, 0x0a, 0x05, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x41, 0x49, 0x53, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x45, 0x44, 0x10, 0x01, 0x22, 0xab, 0x01, 0x0a,
+	0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x2e, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x80, 0x01, 0x0a, 0x04, 0x53,
+	0x74, 0x6f, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xfa, 0x01,
+	0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x04, 0x73, 0x74, 0x6f,
+	0x70, 0x12, 0x34, 0x0a, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x61, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x22, 0x7b, 0x0a, 0x1a, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x6f, 0x70, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x44, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x74, 0x6f, 0x70, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x65, 0x64,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xf7, 0x01,
+	0x0a, 0x09, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x53, 0x74, 0x6f, 0x70,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
 	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x39, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x07, 0x0a, 0x03, 0x42, 0x55, 0x53, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x52, 0x4f,
-	0x4c, 0x4c, 0x45, 0x59, 0x42, 0x55, 0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x52, 0x41,
-	0x4d, 0x57, 0x41, 0x59, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x49, 0x4e, 0x49, 0x42, 0x55,
-	0x53, 0x10, 0x03, 0x22, 0xa1, 0x01, 0x0a, 0x08, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x04, 0x46,
-	0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x54,
-	0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x02, 0x54, 0x6f, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x47, 0x50, 0x53, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x16, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x75, 0x73, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x8d, 0x01, 0x0a, 0x12, 0x42, 0x75, 0x73,
-	0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x33, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x47, 0x50, 0x53, 0x44, 0x61, 0x74, 0x61,
-	0x12, 0x08, 0x2e, 0x47, 0x50, 0x53, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x16, 0x2e, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x47, 0x50, 0x53, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x28, 0x01, 0x12, 0x42, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x75,
-	0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x15, 0x2e,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x75, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x42, 0x75, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69,
-	0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x30, 0x01, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x72, 0x73, 0x34, 0x33, 0x72, 0x75, 0x2f,
-	0x62, 0x75, 0x73, 0x32, 0x6d, 0x61, 0x70, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x75, 0x73, 0x74,
-	0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x3b, 0x62, 0x75, 0x73, 0x74, 0x72, 0x61, 0x63, 0x6b,
-	0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x62, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x70,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x04, 0x73,
+	0x74, 0x6f, 0x70, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0x22, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0b, 0x0a, 0x07, 0x41,
+	0x52, 0x52, 0x49, 0x56, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x45, 0x50, 0x41,
+	0x52, 0x54, 0x55, 0x52, 0x45, 0x10, 0x01, 0x22, 0x55, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x6f, 0x70, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xa7,
+	0x01, 0x0a, 0x0e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x48, 0x65, 0x61, 0x64, 0x77, 0x61,
+	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x6c, 0x6f, 0x6e, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6c,
+	0x6f, 0x6e, 0x67, 0x12, 0x33, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x77, 0x61, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x77, 0x61, 0x79, 0x22, 0xe4, 0x02, 0x0a, 0x0c, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x77, 0x61, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x07,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
+	0x64, 0x12, 0x2b, 0x0a, 0x08, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x48, 0x65, 0x61,
+	0x64, 0x77, 0x61, 0x79, 0x52, 0x08, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x2d,
+	0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12, 0x2b, 0x0a,
+	0x03, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x2b, 0x0a, 0x03, 0x6d, 0x61,
+	0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x75, 0x6e, 0x63, 0x68,
+	0x69, 0x6e, 0x67, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x62, 0x75, 0x6e, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x61, 0x70, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x67, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x38, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x77, 0x61, 0x79, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x3b, 0x0a, 0x14, 0x4c, 0x69, 0x73,
+	0x74, 0x48, 0x65, 0x61, 0x64, 0x77, 0x61, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x23, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x77, 0x61, 0x79, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xe0, 0x02, 0x0a, 0x0e, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x3f, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d,
+	0x12, 0x3b, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x74, 0x6f, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x6f, 0x12, 0x1f, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x37,
+	0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x62, 0x0a, 0x1a, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x2e, 0x4b,
+	0x69, 0x6e, 0x64, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x44, 0x0a,
+	0x1b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x68, 0x69,
+	0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x4d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0xa9, 0x03, 0x0a, 0x09, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x6b, 0x65,
+	0x77, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x2d, 0x0a,
+	0x04, 0x73, 0x6b, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x73, 0x6b, 0x65, 0x77, 0x12, 0x34, 0x0a, 0x08,
+	0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x6b, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x53, 0x6b,
+	0x65, 0x77, 0x12, 0x34, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6b, 0x65, 0x77, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x6d, 0x61, 0x78, 0x53, 0x6b, 0x65, 0x77, 0x12, 0x31, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x6b, 0x65, 0x77, 0x2e, 0x43, 0x6f, 0x72, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x30, 0x0a,
+	0x0a, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x4e,
+	0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x01, 0x12,
+	0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x10, 0x02, 0x22,
+	0x28, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x6b, 0x65, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x39, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x6b, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x6b, 0x65, 0x77, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x09, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x39, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x35, 0x0a, 0x08, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x54,
+	0x6f, 0x22, 0x52, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x47, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xbb,
+	0x01, 0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd7, 0x01, 0x0a,
+	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x39, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x22, 0x40, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0x2d, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x4a, 0x04, 0x08, 0x02,
+	0x10, 0x03, 0x32, 0xf2, 0x06, 0x0a, 0x12, 0x42, 0x75, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x0d, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x47, 0x50, 0x53, 0x44, 0x61, 0x74, 0x61, 0x12, 0x08, 0x2e, 0x47, 0x50, 0x53,
+	0x44, 0x61, 0x74, 0x61, 0x1a, 0x16, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x47, 0x50, 0x53,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x42,
+	0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x75, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x15, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x42, 0x75, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x42, 0x75, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x30, 0x01, 0x12, 0x44, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x17, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56,
+	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69,
+	0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x56, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x14, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x06, 0x2e, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6f,
+	0x70, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x77,
+	0x61, 0x79, 0x73, 0x12, 0x14, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x77, 0x61,
+	0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x48, 0x65, 0x61, 0x64, 0x77, 0x61, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x50, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x56,
+	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x6b, 0x65, 0x77, 0x12, 0x15, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x6b, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x6b, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x53, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xd3, 0x01, 0x0a, 0x17, 0x42, 0x75, 0x73, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x16, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x12, 0x3e, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x73, 0x12, 0x15, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x12, 0x16, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x42, 0x39, 0x5a,
+	0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x72, 0x73,
+	0x34, 0x33, 0x72, 0x75, 0x2f, 0x62, 0x75, 0x73, 0x32, 0x6d, 0x61, 0x70, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x62, 0x75, 0x73, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x3b, 0x62, 0x75, 0x73,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -571,37 +3932,179 @@ func file_api_proto_bustracking_proto_rawDescGZIP() []byte {
 	return file_api_proto_bustracking_proto_rawDescData
 }
 
-var file_api_proto_bustracking_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_proto_bustracking_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_proto_bustracking_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_api_proto_bustracking_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_api_proto_bustracking_proto_goTypes = []any{
-	(Transport_Type)(0),           // 0: Transport.Type
-	(*GPSData)(nil),               // 1: GPSData
-	(*BusTrackingInfo)(nil),       // 2: BusTrackingInfo
-	(*Route)(nil),                 // 3: Route
-	(*Transport)(nil),             // 4: Transport
-	(*Schedule)(nil),              // 5: Schedule
-	(*StreamGPSDataResponse)(nil), // 6: StreamGPSDataResponse
-	(*StreamBusDataRequest)(nil),  // 7: StreamBusDataRequest
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(BusTrackingInfo_ScheduleMatch)(0),   // 0: BusTrackingInfo.ScheduleMatch
+	(Adherence_Status)(0),                // 1: Adherence.Status
+	(Zone_Type)(0),                       // 2: Zone.Type
+	(Transport_Type)(0),                  // 3: Transport.Type
+	(Diagnostic_Kind)(0),                 // 4: Diagnostic.Kind
+	(Alert_Kind)(0),                      // 5: Alert.Kind
+	(Alert_State)(0),                     // 6: Alert.State
+	(StopEvent_Kind)(0),                  // 7: StopEvent.Kind
+	(ClockSkew_Correction)(0),            // 8: ClockSkew.Correction
+	(*GPSData)(nil),                      // 9: GPSData
+	(*BusTrackingInfo)(nil),              // 10: BusTrackingInfo
+	(*Adherence)(nil),                    // 11: Adherence
+	(*RouteMatch)(nil),                   // 12: RouteMatch
+	(*Zone)(nil),                         // 13: Zone
+	(*Route)(nil),                        // 14: Route
+	(*Transport)(nil),                    // 15: Transport
+	(*Schedule)(nil),                     // 16: Schedule
+	(*StreamGPSDataResponse)(nil),        // 17: StreamGPSDataResponse
+	(*StreamBusDataRequest)(nil),         // 18: StreamBusDataRequest
+	(*Diagnostic)(nil),                   // 19: Diagnostic
+	(*ListDiagnosticsRequest)(nil),       // 20: ListDiagnosticsRequest
+	(*ListDiagnosticsResponse)(nil),      // 21: ListDiagnosticsResponse
+	(*VehicleState)(nil),                 // 22: VehicleState
+	(*GetVehicleRequest)(nil),            // 23: GetVehicleRequest
+	(*ListVehiclesRequest)(nil),          // 24: ListVehiclesRequest
+	(*ListVehiclesResponse)(nil),         // 25: ListVehiclesResponse
+	(*RouteActivity)(nil),                // 26: RouteActivity
+	(*ListRoutesActivityRequest)(nil),    // 27: ListRoutesActivityRequest
+	(*ListRoutesActivityResponse)(nil),   // 28: ListRoutesActivityResponse
+	(*Alert)(nil),                        // 29: Alert
+	(*StreamAlertsRequest)(nil),          // 30: StreamAlertsRequest
+	(*Stop)(nil),                         // 31: Stop
+	(*StopPrediction)(nil),               // 32: StopPrediction
+	(*ListStopPredictionsRequest)(nil),   // 33: ListStopPredictionsRequest
+	(*ListStopPredictionsResponse)(nil),  // 34: ListStopPredictionsResponse
+	(*StopEvent)(nil),                    // 35: StopEvent
+	(*StreamStopEventsRequest)(nil),      // 36: StreamStopEventsRequest
+	(*VehicleHeadway)(nil),               // 37: VehicleHeadway
+	(*RouteHeadway)(nil),                 // 38: RouteHeadway
+	(*ListHeadwaysRequest)(nil),          // 39: ListHeadwaysRequest
+	(*ListHeadwaysResponse)(nil),         // 40: ListHeadwaysResponse
+	(*MissingVehicle)(nil),               // 41: MissingVehicle
+	(*ListMissingVehiclesRequest)(nil),   // 42: ListMissingVehiclesRequest
+	(*ListMissingVehiclesResponse)(nil),  // 43: ListMissingVehiclesResponse
+	(*ClockSkew)(nil),                    // 44: ClockSkew
+	(*ListClockSkewRequest)(nil),         // 45: ListClockSkewRequest
+	(*ListClockSkewResponse)(nil),        // 46: ListClockSkewResponse
+	(*TransportBinding)(nil),             // 47: TransportBinding
+	(*ListTransportHistoryRequest)(nil),  // 48: ListTransportHistoryRequest
+	(*ListTransportHistoryResponse)(nil), // 49: ListTransportHistoryResponse
+	(*ScheduleOverride)(nil),             // 50: ScheduleOverride
+	(*CreateOverrideRequest)(nil),        // 51: CreateOverrideRequest
+	(*ListOverridesRequest)(nil),         // 52: ListOverridesRequest
+	(*ListOverridesResponse)(nil),        // 53: ListOverridesResponse
+	(*DeleteOverrideRequest)(nil),        // 54: DeleteOverrideRequest
+	(*timestamppb.Timestamp)(nil),        // 55: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),          // 56: google.protobuf.Duration
 }
 var file_api_proto_bustracking_proto_depIdxs = []int32{
-	8,  // 0: GPSData.time:type_name -> google.protobuf.Timestamp
-	1,  // 1: BusTrackingInfo.gps_data:type_name -> GPSData
-	3,  // 2: BusTrackingInfo.route:type_name -> Route
-	4,  // 3: BusTrackingInfo.transport:type_name -> Transport
-	5,  // 4: BusTrackingInfo.schedule:type_name -> Schedule
-	0,  // 5: Transport.type:type_name -> Transport.Type
-	8,  // 6: Schedule.From:type_name -> google.protobuf.Timestamp
-	8,  // 7: Schedule.To:type_name -> google.protobuf.Timestamp
-	1,  // 8: BusTrackingService.StreamGPSData:input_type -> GPSData
-	7,  // 9: BusTrackingService.StreamBusTrackingInfo:input_type -> StreamBusDataRequest
-	6,  // 10: BusTrackingService.StreamGPSData:output_type -> StreamGPSDataResponse
-	2,  // 11: BusTrackingService.StreamBusTrackingInfo:output_type -> BusTrackingInfo
-	10, // [10:12] is the sub-list for method output_type
-	8,  // [8:10] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	55, // 0: GPSData.time:type_name -> google.protobuf.Timestamp
+	9,  // 1: BusTrackingInfo.gps_data:type_name -> GPSData
+	14, // 2: BusTrackingInfo.route:type_name -> Route
+	15, // 3: BusTrackingInfo.transport:type_name -> Transport
+	16, // 4: BusTrackingInfo.schedule:type_name -> Schedule
+	13, // 5: BusTrackingInfo.zones:type_name -> Zone
+	12, // 6: BusTrackingInfo.route_match:type_name -> RouteMatch
+	11, // 7: BusTrackingInfo.adherence:type_name -> Adherence
+	0,  // 8: BusTrackingInfo.schedule_match:type_name -> BusTrackingInfo.ScheduleMatch
+	1,  // 9: Adherence.status:type_name -> Adherence.Status
+	2,  // 10: Zone.type:type_name -> Zone.Type
+	3,  // 11: Transport.type:type_name -> Transport.Type
+	55, // 12: Schedule.From:type_name -> google.protobuf.Timestamp
+	55, // 13: Schedule.To:type_name -> google.protobuf.Timestamp
+	4,  // 14: Diagnostic.kind:type_name -> Diagnostic.Kind
+	55, // 15: Diagnostic.first_seen:type_name -> google.protobuf.Timestamp
+	55, // 16: Diagnostic.last_seen:type_name -> google.protobuf.Timestamp
+	9,  // 17: Diagnostic.last_gps_data:type_name -> GPSData
+	4,  // 18: ListDiagnosticsRequest.kinds:type_name -> Diagnostic.Kind
+	19, // 19: ListDiagnosticsResponse.items:type_name -> Diagnostic
+	10, // 20: VehicleState.info:type_name -> BusTrackingInfo
+	55, // 21: VehicleState.updated_at:type_name -> google.protobuf.Timestamp
+	56, // 22: VehicleState.age:type_name -> google.protobuf.Duration
+	3,  // 23: ListVehiclesRequest.types:type_name -> Transport.Type
+	55, // 24: ListVehiclesRequest.active_since:type_name -> google.protobuf.Timestamp
+	22, // 25: ListVehiclesResponse.items:type_name -> VehicleState
+	14, // 26: RouteActivity.route:type_name -> Route
+	55, // 27: RouteActivity.last_update:type_name -> google.protobuf.Timestamp
+	55, // 28: ListRoutesActivityRequest.active_since:type_name -> google.protobuf.Timestamp
+	26, // 29: ListRoutesActivityResponse.items:type_name -> RouteActivity
+	5,  // 30: Alert.kind:type_name -> Alert.Kind
+	6,  // 31: Alert.state:type_name -> Alert.State
+	55, // 32: Alert.since:type_name -> google.protobuf.Timestamp
+	55, // 33: Alert.time:type_name -> google.protobuf.Timestamp
+	9,  // 34: Alert.gps_data:type_name -> GPSData
+	56, // 35: Alert.headway:type_name -> google.protobuf.Duration
+	5,  // 36: StreamAlertsRequest.kinds:type_name -> Alert.Kind
+	31, // 37: StopPrediction.stop:type_name -> Stop
+	55, // 38: StopPrediction.arrival:type_name -> google.protobuf.Timestamp
+	55, // 39: StopPrediction.departure:type_name -> google.protobuf.Timestamp
+	32, // 40: ListStopPredictionsResponse.items:type_name -> StopPrediction
+	7,  // 41: StopEvent.kind:type_name -> StopEvent.Kind
+	31, // 42: StopEvent.stop:type_name -> Stop
+	55, // 43: StopEvent.time:type_name -> google.protobuf.Timestamp
+	56, // 44: VehicleHeadway.headway:type_name -> google.protobuf.Duration
+	56, // 45: RouteHeadway.planned:type_name -> google.protobuf.Duration
+	37, // 46: RouteHeadway.vehicles:type_name -> VehicleHeadway
+	56, // 47: RouteHeadway.mean:type_name -> google.protobuf.Duration
+	56, // 48: RouteHeadway.min:type_name -> google.protobuf.Duration
+	56, // 49: RouteHeadway.max:type_name -> google.protobuf.Duration
+	38, // 50: ListHeadwaysResponse.items:type_name -> RouteHeadway
+	55, // 51: MissingVehicle.schedule_from:type_name -> google.protobuf.Timestamp
+	55, // 52: MissingVehicle.schedule_to:type_name -> google.protobuf.Timestamp
+	5,  // 53: MissingVehicle.kind:type_name -> Alert.Kind
+	55, // 54: MissingVehicle.last_seen:type_name -> google.protobuf.Timestamp
+	55, // 55: MissingVehicle.since:type_name -> google.protobuf.Timestamp
+	5,  // 56: ListMissingVehiclesRequest.kinds:type_name -> Alert.Kind
+	41, // 57: ListMissingVehiclesResponse.items:type_name -> MissingVehicle
+	56, // 58: ClockSkew.skew:type_name -> google.protobuf.Duration
+	56, // 59: ClockSkew.min_skew:type_name -> google.protobuf.Duration
+	56, // 60: ClockSkew.max_skew:type_name -> google.protobuf.Duration
+	56, // 61: ClockSkew.offset:type_name -> google.protobuf.Duration
+	8,  // 62: ClockSkew.correction:type_name -> ClockSkew.Correction
+	55, // 63: ClockSkew.updated_at:type_name -> google.protobuf.Timestamp
+	44, // 64: ListClockSkewResponse.items:type_name -> ClockSkew
+	15, // 65: TransportBinding.transport:type_name -> Transport
+	55, // 66: TransportBinding.valid_from:type_name -> google.protobuf.Timestamp
+	55, // 67: TransportBinding.valid_to:type_name -> google.protobuf.Timestamp
+	47, // 68: ListTransportHistoryResponse.items:type_name -> TransportBinding
+	16, // 69: ScheduleOverride.schedule:type_name -> Schedule
+	55, // 70: ScheduleOverride.created_at:type_name -> google.protobuf.Timestamp
+	55, // 71: CreateOverrideRequest.from:type_name -> google.protobuf.Timestamp
+	55, // 72: CreateOverrideRequest.to:type_name -> google.protobuf.Timestamp
+	50, // 73: ListOverridesResponse.items:type_name -> ScheduleOverride
+	9,  // 74: BusTrackingService.StreamGPSData:input_type -> GPSData
+	18, // 75: BusTrackingService.StreamBusTrackingInfo:input_type -> StreamBusDataRequest
+	20, // 76: BusTrackingService.ListDiagnostics:input_type -> ListDiagnosticsRequest
+	23, // 77: BusTrackingService.GetVehicle:input_type -> GetVehicleRequest
+	24, // 78: BusTrackingService.ListVehicles:input_type -> ListVehiclesRequest
+	27, // 79: BusTrackingService.ListRoutesActivity:input_type -> ListRoutesActivityRequest
+	30, // 80: BusTrackingService.StreamAlerts:input_type -> StreamAlertsRequest
+	33, // 81: BusTrackingService.ListStopPredictions:input_type -> ListStopPredictionsRequest
+	36, // 82: BusTrackingService.StreamStopEvents:input_type -> StreamStopEventsRequest
+	39, // 83: BusTrackingService.ListHeadways:input_type -> ListHeadwaysRequest
+	42, // 84: BusTrackingService.ListMissingVehicles:input_type -> ListMissingVehiclesRequest
+	45, // 85: BusTrackingService.ListClockSkew:input_type -> ListClockSkewRequest
+	48, // 86: BusTrackingService.ListTransportHistory:input_type -> ListTransportHistoryRequest
+	51, // 87: BusTrackingAdminService.CreateOverride:input_type -> CreateOverrideRequest
+	52, // 88: BusTrackingAdminService.ListOverrides:input_type -> ListOverridesRequest
+	54, // 89: BusTrackingAdminService.DeleteOverride:input_type -> DeleteOverrideRequest
+	17, // 90: BusTrackingService.StreamGPSData:output_type -> StreamGPSDataResponse
+	10, // 91: BusTrackingService.StreamBusTrackingInfo:output_type -> BusTrackingInfo
+	21, // 92: BusTrackingService.ListDiagnostics:output_type -> ListDiagnosticsResponse
+	22, // 93: BusTrackingService.GetVehicle:output_type -> VehicleState
+	25, // 94: BusTrackingService.ListVehicles:output_type -> ListVehiclesResponse
+	28, // 95: BusTrackingService.ListRoutesActivity:output_type -> ListRoutesActivityResponse
+	29, // 96: BusTrackingService.StreamAlerts:output_type -> Alert
+	34, // 97: BusTrackingService.ListStopPredictions:output_type -> ListStopPredictionsResponse
+	35, // 98: BusTrackingService.StreamStopEvents:output_type -> StopEvent
+	40, // 99: BusTrackingService.ListHeadways:output_type -> ListHeadwaysResponse
+	43, // 100: BusTrackingService.ListMissingVehicles:output_type -> ListMissingVehiclesResponse
+	46, // 101: BusTrackingService.ListClockSkew:output_type -> ListClockSkewResponse
+	49, // 102: BusTrackingService.ListTransportHistory:output_type -> ListTransportHistoryResponse
+	50, // 103: BusTrackingAdminService.CreateOverride:output_type -> ScheduleOverride
+	53, // 104: BusTrackingAdminService.ListOverrides:output_type -> ListOverridesResponse
+	50, // 105: BusTrackingAdminService.DeleteOverride:output_type -> ScheduleOverride
+	90, // [90:106] is the sub-list for method output_type
+	74, // [74:90] is the sub-list for method input_type
+	74, // [74:74] is the sub-list for extension type_name
+	74, // [74:74] is the sub-list for extension extendee
+	0,  // [0:74] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_bustracking_proto_init() }
@@ -609,15 +4112,19 @@ func file_api_proto_bustracking_proto_init() {
 	if File_api_proto_bustracking_proto != nil {
 		return
 	}
+	file_api_proto_bustracking_proto_msgTypes[14].OneofWrappers = []any{
+		(*GetVehicleRequest_StateNumber)(nil),
+		(*GetVehicleRequest_Uid)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_bustracking_proto_rawDesc), len(file_api_proto_bustracking_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   7,
+			NumEnums:      9,
+			NumMessages:   46,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_api_proto_bustracking_proto_goTypes,
 		DependencyIndexes: file_api_proto_bustracking_proto_depIdxs,
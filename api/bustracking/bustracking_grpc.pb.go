@@ -21,6 +21,17 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	BusTrackingService_StreamGPSData_FullMethodName         = "/BusTrackingService/StreamGPSData"
 	BusTrackingService_StreamBusTrackingInfo_FullMethodName = "/BusTrackingService/StreamBusTrackingInfo"
+	BusTrackingService_ListDiagnostics_FullMethodName       = "/BusTrackingService/ListDiagnostics"
+	BusTrackingService_GetVehicle_FullMethodName            = "/BusTrackingService/GetVehicle"
+	BusTrackingService_ListVehicles_FullMethodName          = "/BusTrackingService/ListVehicles"
+	BusTrackingService_ListRoutesActivity_FullMethodName    = "/BusTrackingService/ListRoutesActivity"
+	BusTrackingService_StreamAlerts_FullMethodName          = "/BusTrackingService/StreamAlerts"
+	BusTrackingService_ListStopPredictions_FullMethodName   = "/BusTrackingService/ListStopPredictions"
+	BusTrackingService_StreamStopEvents_FullMethodName      = "/BusTrackingService/StreamStopEvents"
+	BusTrackingService_ListHeadways_FullMethodName          = "/BusTrackingService/ListHeadways"
+	BusTrackingService_ListMissingVehicles_FullMethodName   = "/BusTrackingService/ListMissingVehicles"
+	BusTrackingService_ListClockSkew_FullMethodName         = "/BusTrackingService/ListClockSkew"
+	BusTrackingService_ListTransportHistory_FullMethodName  = "/BusTrackingService/ListTransportHistory"
 )
 
 // BusTrackingServiceClient is the client API for BusTrackingService service.
@@ -31,6 +42,28 @@ type BusTrackingServiceClient interface {
 	StreamGPSData(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[GPSData, StreamGPSDataResponse], error)
 	// Поток для получения обогащенных данных о автобусе и маршруте
 	StreamBusTrackingInfo(ctx context.Context, in *StreamBusDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BusTrackingInfo], error)
+	// Список GPS-данных, которые не удалось сопоставить с транспортом, расписанием или маршрутом
+	ListDiagnostics(ctx context.Context, in *ListDiagnosticsRequest, opts ...grpc.CallOption) (*ListDiagnosticsResponse, error)
+	// Последнее известное состояние транспортного средства по госномеру или UID
+	GetVehicle(ctx context.Context, in *GetVehicleRequest, opts ...grpc.CallOption) (*VehicleState, error)
+	// Последнее известное состояние транспортных средств с отбором по маршруту, типу и времени активности
+	ListVehicles(ctx context.Context, in *ListVehiclesRequest, opts ...grpc.CallOption) (*ListVehiclesResponse, error)
+	// Активность транспортных средств в разрезе маршрутов
+	ListRoutesActivity(ctx context.Context, in *ListRoutesActivityRequest, opts ...grpc.CallOption) (*ListRoutesActivityResponse, error)
+	// Поток событий для диспетчера: возникновение и завершение
+	StreamAlerts(ctx context.Context, in *StreamAlertsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Alert], error)
+	// Прогноз прибытия транспортных средств на остановки
+	ListStopPredictions(ctx context.Context, in *ListStopPredictionsRequest, opts ...grpc.CallOption) (*ListStopPredictionsResponse, error)
+	// Поток событий прибытия на остановки и отправления с них
+	StreamStopEvents(ctx context.Context, in *StreamStopEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StopEvent], error)
+	// Интервалы движения по маршрутам
+	ListHeadways(ctx context.Context, in *ListHeadwaysRequest, opts ...grpc.CallOption) (*ListHeadwaysResponse, error)
+	// Транспорт, который должен работать по расписанию, но не передает данные
+	ListMissingVehicles(ctx context.Context, in *ListMissingVehiclesRequest, opts ...grpc.CallOption) (*ListMissingVehiclesResponse, error)
+	// Расхождение часов трекеров со временем сервера
+	ListClockSkew(ctx context.Context, in *ListClockSkewRequest, opts ...grpc.CallOption) (*ListClockSkewResponse, error)
+	// История привязок трекеров к транспорту
+	ListTransportHistory(ctx context.Context, in *ListTransportHistoryRequest, opts ...grpc.CallOption) (*ListTransportHistoryResponse, error)
 }
 
 type busTrackingServiceClient struct {
@@ -73,6 +106,134 @@ func (c *busTrackingServiceClient) StreamBusTrackingInfo(ctx context.Context, in
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type BusTrackingService_StreamBusTrackingInfoClient = grpc.ServerStreamingClient[BusTrackingInfo]
 
+func (c *busTrackingServiceClient) ListDiagnostics(ctx context.Context, in *ListDiagnosticsRequest, opts ...grpc.CallOption) (*ListDiagnosticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, BusTrackingService_ListDiagnostics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *busTrackingServiceClient) GetVehicle(ctx context.Context, in *GetVehicleRequest, opts ...grpc.CallOption) (*VehicleState, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VehicleState)
+	err := c.cc.Invoke(ctx, BusTrackingService_GetVehicle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *busTrackingServiceClient) ListVehicles(ctx context.Context, in *ListVehiclesRequest, opts ...grpc.CallOption) (*ListVehiclesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVehiclesResponse)
+	err := c.cc.Invoke(ctx, BusTrackingService_ListVehicles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *busTrackingServiceClient) ListRoutesActivity(ctx context.Context, in *ListRoutesActivityRequest, opts ...grpc.CallOption) (*ListRoutesActivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRoutesActivityResponse)
+	err := c.cc.Invoke(ctx, BusTrackingService_ListRoutesActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *busTrackingServiceClient) StreamAlerts(ctx context.Context, in *StreamAlertsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Alert], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BusTrackingService_ServiceDesc.Streams[2], BusTrackingService_StreamAlerts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamAlertsRequest, Alert]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BusTrackingService_StreamAlertsClient = grpc.ServerStreamingClient[Alert]
+
+func (c *busTrackingServiceClient) ListStopPredictions(ctx context.Context, in *ListStopPredictionsRequest, opts ...grpc.CallOption) (*ListStopPredictionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStopPredictionsResponse)
+	err := c.cc.Invoke(ctx, BusTrackingService_ListStopPredictions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *busTrackingServiceClient) StreamStopEvents(ctx context.Context, in *StreamStopEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StopEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BusTrackingService_ServiceDesc.Streams[3], BusTrackingService_StreamStopEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamStopEventsRequest, StopEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BusTrackingService_StreamStopEventsClient = grpc.ServerStreamingClient[StopEvent]
+
+func (c *busTrackingServiceClient) ListHeadways(ctx context.Context, in *ListHeadwaysRequest, opts ...grpc.CallOption) (*ListHeadwaysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHeadwaysResponse)
+	err := c.cc.Invoke(ctx, BusTrackingService_ListHeadways_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *busTrackingServiceClient) ListMissingVehicles(ctx context.Context, in *ListMissingVehiclesRequest, opts ...grpc.CallOption) (*ListMissingVehiclesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMissingVehiclesResponse)
+	err := c.cc.Invoke(ctx, BusTrackingService_ListMissingVehicles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *busTrackingServiceClient) ListClockSkew(ctx context.Context, in *ListClockSkewRequest, opts ...grpc.CallOption) (*ListClockSkewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClockSkewResponse)
+	err := c.cc.Invoke(ctx, BusTrackingService_ListClockSkew_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *busTrackingServiceClient) ListTransportHistory(ctx context.Context, in *ListTransportHistoryRequest, opts ...grpc.CallOption) (*ListTransportHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransportHistoryResponse)
+	err := c.cc.Invoke(ctx, BusTrackingService_ListTransportHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BusTrackingServiceServer is the server API for BusTrackingService service.
 // All implementations must embed UnimplementedBusTrackingServiceServer
 // for forward compatibility.
@@ -81,6 +242,28 @@ type BusTrackingServiceServer interface {
 	StreamGPSData(grpc.ClientStreamingServer[GPSData, StreamGPSDataResponse]) error
 	// Поток для получения обогащенных данных о автобусе и маршруте
 	StreamBusTrackingInfo(*StreamBusDataRequest, grpc.ServerStreamingServer[BusTrackingInfo]) error
+	// Список GPS-данных, которые не удалось сопоставить с транспортом, расписанием или маршрутом
+	ListDiagnostics(context.Context, *ListDiagnosticsRequest) (*ListDiagnosticsResponse, error)
+	// Последнее известное состояние транспортного средства по госномеру или UID
+	GetVehicle(context.Context, *GetVehicleRequest) (*VehicleState, error)
+	// Последнее известное состояние транспортных средств с отбором по маршруту, типу и времени активности
+	ListVehicles(context.Context, *ListVehiclesRequest) (*ListVehiclesResponse, error)
+	// Активность транспортных средств в разрезе маршрутов
+	ListRoutesActivity(context.Context, *ListRoutesActivityRequest) (*ListRoutesActivityResponse, error)
+	// Поток событий для диспетчера: возникновение и завершение
+	StreamAlerts(*StreamAlertsRequest, grpc.ServerStreamingServer[Alert]) error
+	// Прогноз прибытия транспортных средств на остановки
+	ListStopPredictions(context.Context, *ListStopPredictionsRequest) (*ListStopPredictionsResponse, error)
+	// Поток событий прибытия на остановки и отправления с них
+	StreamStopEvents(*StreamStopEventsRequest, grpc.ServerStreamingServer[StopEvent]) error
+	// Интервалы движения по маршрутам
+	ListHeadways(context.Context, *ListHeadwaysRequest) (*ListHeadwaysResponse, error)
+	// Транспорт, который должен работать по расписанию, но не передает данные
+	ListMissingVehicles(context.Context, *ListMissingVehiclesRequest) (*ListMissingVehiclesResponse, error)
+	// Расхождение часов трекеров со временем сервера
+	ListClockSkew(context.Context, *ListClockSkewRequest) (*ListClockSkewResponse, error)
+	// История привязок трекеров к транспорту
+	ListTransportHistory(context.Context, *ListTransportHistoryRequest) (*ListTransportHistoryResponse, error)
 	mustEmbedUnimplementedBusTrackingServiceServer()
 }
 
@@ -97,6 +280,39 @@ func (UnimplementedBusTrackingServiceServer) StreamGPSData(grpc.ClientStreamingS
 func (UnimplementedBusTrackingServiceServer) StreamBusTrackingInfo(*StreamBusDataRequest, grpc.ServerStreamingServer[BusTrackingInfo]) error {
 	return status.Errorf(codes.Unimplemented, "method StreamBusTrackingInfo not implemented")
 }
+func (UnimplementedBusTrackingServiceServer) ListDiagnostics(context.Context, *ListDiagnosticsRequest) (*ListDiagnosticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDiagnostics not implemented")
+}
+func (UnimplementedBusTrackingServiceServer) GetVehicle(context.Context, *GetVehicleRequest) (*VehicleState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVehicle not implemented")
+}
+func (UnimplementedBusTrackingServiceServer) ListVehicles(context.Context, *ListVehiclesRequest) (*ListVehiclesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVehicles not implemented")
+}
+func (UnimplementedBusTrackingServiceServer) ListRoutesActivity(context.Context, *ListRoutesActivityRequest) (*ListRoutesActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoutesActivity not implemented")
+}
+func (UnimplementedBusTrackingServiceServer) StreamAlerts(*StreamAlertsRequest, grpc.ServerStreamingServer[Alert]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAlerts not implemented")
+}
+func (UnimplementedBusTrackingServiceServer) ListStopPredictions(context.Context, *ListStopPredictionsRequest) (*ListStopPredictionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStopPredictions not implemented")
+}
+func (UnimplementedBusTrackingServiceServer) StreamStopEvents(*StreamStopEventsRequest, grpc.ServerStreamingServer[StopEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamStopEvents not implemented")
+}
+func (UnimplementedBusTrackingServiceServer) ListHeadways(context.Context, *ListHeadwaysRequest) (*ListHeadwaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHeadways not implemented")
+}
+func (UnimplementedBusTrackingServiceServer) ListMissingVehicles(context.Context, *ListMissingVehiclesRequest) (*ListMissingVehiclesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMissingVehicles not implemented")
+}
+func (UnimplementedBusTrackingServiceServer) ListClockSkew(context.Context, *ListClockSkewRequest) (*ListClockSkewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClockSkew not implemented")
+}
+func (UnimplementedBusTrackingServiceServer) ListTransportHistory(context.Context, *ListTransportHistoryRequest) (*ListTransportHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransportHistory not implemented")
+}
 func (UnimplementedBusTrackingServiceServer) mustEmbedUnimplementedBusTrackingServiceServer() {}
 func (UnimplementedBusTrackingServiceServer) testEmbeddedByValue()                            {}
 
@@ -108,7 +324,7 @@ type UnsafeBusTrackingServiceServer interface {
 }
 
 func RegisterBusTrackingServiceServer(s grpc.ServiceRegistrar, srv BusTrackingServiceServer) {
-	// If the following call panics, it indicates UnimplementedBusTrackingServiceServer was
+	// If the following call pancis, it indicates UnimplementedBusTrackingServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
@@ -136,13 +352,234 @@ func _BusTrackingService_StreamBusTrackingInfo_Handler(srv interface{}, stream g
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type BusTrackingService_StreamBusTrackingInfoServer = grpc.ServerStreamingServer[BusTrackingInfo]
 
+func _BusTrackingService_ListDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusTrackingServiceServer).ListDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BusTrackingService_ListDiagnostics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusTrackingServiceServer).ListDiagnostics(ctx, req.(*ListDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BusTrackingService_GetVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusTrackingServiceServer).GetVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BusTrackingService_GetVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusTrackingServiceServer).GetVehicle(ctx, req.(*GetVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BusTrackingService_ListVehicles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVehiclesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusTrackingServiceServer).ListVehicles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BusTrackingService_ListVehicles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusTrackingServiceServer).ListVehicles(ctx, req.(*ListVehiclesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BusTrackingService_ListRoutesActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoutesActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusTrackingServiceServer).ListRoutesActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BusTrackingService_ListRoutesActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusTrackingServiceServer).ListRoutesActivity(ctx, req.(*ListRoutesActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BusTrackingService_StreamAlerts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamAlertsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BusTrackingServiceServer).StreamAlerts(m, &grpc.GenericServerStream[StreamAlertsRequest, Alert]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BusTrackingService_StreamAlertsServer = grpc.ServerStreamingServer[Alert]
+
+func _BusTrackingService_ListStopPredictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStopPredictionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusTrackingServiceServer).ListStopPredictions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BusTrackingService_ListStopPredictions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusTrackingServiceServer).ListStopPredictions(ctx, req.(*ListStopPredictionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BusTrackingService_StreamStopEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamStopEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BusTrackingServiceServer).StreamStopEvents(m, &grpc.GenericServerStream[StreamStopEventsRequest, StopEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BusTrackingService_StreamStopEventsServer = grpc.ServerStreamingServer[StopEvent]
+
+func _BusTrackingService_ListHeadways_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHeadwaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusTrackingServiceServer).ListHeadways(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BusTrackingService_ListHeadways_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusTrackingServiceServer).ListHeadways(ctx, req.(*ListHeadwaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BusTrackingService_ListMissingVehicles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMissingVehiclesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusTrackingServiceServer).ListMissingVehicles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BusTrackingService_ListMissingVehicles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusTrackingServiceServer).ListMissingVehicles(ctx, req.(*ListMissingVehiclesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BusTrackingService_ListClockSkew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClockSkewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusTrackingServiceServer).ListClockSkew(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BusTrackingService_ListClockSkew_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusTrackingServiceServer).ListClockSkew(ctx, req.(*ListClockSkewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BusTrackingService_ListTransportHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransportHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusTrackingServiceServer).ListTransportHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BusTrackingService_ListTransportHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusTrackingServiceServer).ListTransportHistory(ctx, req.(*ListTransportHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BusTrackingService_ServiceDesc is the grpc.ServiceDesc for BusTrackingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var BusTrackingService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "BusTrackingService",
 	HandlerType: (*BusTrackingServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDiagnostics",
+			Handler:    _BusTrackingService_ListDiagnostics_Handler,
+		},
+		{
+			MethodName: "GetVehicle",
+			Handler:    _BusTrackingService_GetVehicle_Handler,
+		},
+		{
+			MethodName: "ListVehicles",
+			Handler:    _BusTrackingService_ListVehicles_Handler,
+		},
+		{
+			MethodName: "ListRoutesActivity",
+			Handler:    _BusTrackingService_ListRoutesActivity_Handler,
+		},
+		{
+			MethodName: "ListStopPredictions",
+			Handler:    _BusTrackingService_ListStopPredictions_Handler,
+		},
+		{
+			MethodName: "ListHeadways",
+			Handler:    _BusTrackingService_ListHeadways_Handler,
+		},
+		{
+			MethodName: "ListMissingVehicles",
+			Handler:    _BusTrackingService_ListMissingVehicles_Handler,
+		},
+		{
+			MethodName: "ListClockSkew",
+			Handler:    _BusTrackingService_ListClockSkew_Handler,
+		},
+		{
+			MethodName: "ListTransportHistory",
+			Handler:    _BusTrackingService_ListTransportHistory_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "StreamGPSData",
@@ -154,6 +591,209 @@ var BusTrackingService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _BusTrackingService_StreamBusTrackingInfo_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamAlerts",
+			Handler:       _BusTrackingService_StreamAlerts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamStopEvents",
+			Handler:       _BusTrackingService_StreamStopEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/proto/bustracking.proto",
+}
+
+const (
+	BusTrackingAdminService_CreateOverride_FullMethodName = "/BusTrackingAdminService/CreateOverride"
+	BusTrackingAdminService_ListOverrides_FullMethodName  = "/BusTrackingAdminService/ListOverrides"
+	BusTrackingAdminService_DeleteOverride_FullMethodName = "/BusTrackingAdminService/DeleteOverride"
+)
+
+// BusTrackingAdminServiceClient is the client API for BusTrackingAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Административные операции диспетчера, доступны на отдельном адресе OVERRIDES_LISTEN_ADDR.
+// Запрос передает токен диспетчера в метаданных authorization в формате "Bearer <токен>",
+// по токену определяется диспетчер, записываемый в журнал аудита
+type BusTrackingAdminServiceClient interface {
+	// Назначить транспорт на маршрут с приоритетом над расписанием
+	CreateOverride(ctx context.Context, in *CreateOverrideRequest, opts ...grpc.CallOption) (*ScheduleOverride, error)
+	// Назначения, действующие сейчас или в будущем
+	ListOverrides(ctx context.Context, in *ListOverridesRequest, opts ...grpc.CallOption) (*ListOverridesResponse, error)
+	// Удалить назначение
+	DeleteOverride(ctx context.Context, in *DeleteOverrideRequest, opts ...grpc.CallOption) (*ScheduleOverride, error)
+}
+
+type busTrackingAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBusTrackingAdminServiceClient(cc grpc.ClientConnInterface) BusTrackingAdminServiceClient {
+	return &busTrackingAdminServiceClient{cc}
+}
+
+func (c *busTrackingAdminServiceClient) CreateOverride(ctx context.Context, in *CreateOverrideRequest, opts ...grpc.CallOption) (*ScheduleOverride, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleOverride)
+	err := c.cc.Invoke(ctx, BusTrackingAdminService_CreateOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *busTrackingAdminServiceClient) ListOverrides(ctx context.Context, in *ListOverridesRequest, opts ...grpc.CallOption) (*ListOverridesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOverridesResponse)
+	err := c.cc.Invoke(ctx, BusTrackingAdminService_ListOverrides_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *busTrackingAdminServiceClient) DeleteOverride(ctx context.Context, in *DeleteOverrideRequest, opts ...grpc.CallOption) (*ScheduleOverride, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleOverride)
+	err := c.cc.Invoke(ctx, BusTrackingAdminService_DeleteOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BusTrackingAdminServiceServer is the server API for BusTrackingAdminService service.
+// All implementations must embed UnimplementedBusTrackingAdminServiceServer
+// for forward compatibility.
+//
+// Административные операции диспетчера, доступны на отдельном адресе OVERRIDES_LISTEN_ADDR.
+// Запрос передает токен диспетчера в метаданных authorization в формате "Bearer <токен>",
+// по токену определяется диспетчер, записываемый в журнал аудита
+type BusTrackingAdminServiceServer interface {
+	// Назначить транспорт на маршрут с приоритетом над расписанием
+	CreateOverride(context.Context, *CreateOverrideRequest) (*ScheduleOverride, error)
+	// Назначения, действующие сейчас или в будущем
+	ListOverrides(context.Context, *ListOverridesRequest) (*ListOverridesResponse, error)
+	// Удалить назначение
+	DeleteOverride(context.Context, *DeleteOverrideRequest) (*ScheduleOverride, error)
+	mustEmbedUnimplementedBusTrackingAdminServiceServer()
+}
+
+// UnimplementedBusTrackingAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBusTrackingAdminServiceServer struct{}
+
+func (UnimplementedBusTrackingAdminServiceServer) CreateOverride(context.Context, *CreateOverrideRequest) (*ScheduleOverride, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOverride not implemented")
+}
+func (UnimplementedBusTrackingAdminServiceServer) ListOverrides(context.Context, *ListOverridesRequest) (*ListOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOverrides not implemented")
+}
+func (UnimplementedBusTrackingAdminServiceServer) DeleteOverride(context.Context, *DeleteOverrideRequest) (*ScheduleOverride, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOverride not implemented")
+}
+func (UnimplementedBusTrackingAdminServiceServer) mustEmbedUnimplementedBusTrackingAdminServiceServer() {
+}
+func (UnimplementedBusTrackingAdminServiceServer) testEmbeddedByValue() {}
+
+// UnsafeBusTrackingAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BusTrackingAdminServiceServer will
+// result in compilation errors.
+type UnsafeBusTrackingAdminServiceServer interface {
+	mustEmbedUnimplementedBusTrackingAdminServiceServer()
+}
+
+func RegisterBusTrackingAdminServiceServer(s grpc.ServiceRegistrar, srv BusTrackingAdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBusTrackingAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BusTrackingAdminService_ServiceDesc, srv)
+}
+
+func _BusTrackingAdminService_CreateOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusTrackingAdminServiceServer).CreateOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BusTrackingAdminService_CreateOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusTrackingAdminServiceServer).CreateOverride(ctx, req.(*CreateOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BusTrackingAdminService_ListOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusTrackingAdminServiceServer).ListOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BusTrackingAdminService_ListOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusTrackingAdminServiceServer).ListOverrides(ctx, req.(*ListOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BusTrackingAdminService_DeleteOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusTrackingAdminServiceServer).DeleteOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BusTrackingAdminService_DeleteOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusTrackingAdminServiceServer).DeleteOverride(ctx, req.(*DeleteOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BusTrackingAdminService_ServiceDesc is the grpc.ServiceDesc for BusTrackingAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BusTrackingAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "BusTrackingAdminService",
+	HandlerType: (*BusTrackingAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOverride",
+			Handler:    _BusTrackingAdminService_CreateOverride_Handler,
+		},
+		{
+			MethodName: "ListOverrides",
+			Handler:    _BusTrackingAdminService_ListOverrides_Handler,
+		},
+		{
+			MethodName: "DeleteOverride",
+			Handler:    _BusTrackingAdminService_DeleteOverride_Handler,
+		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/bustracking.proto",
 }
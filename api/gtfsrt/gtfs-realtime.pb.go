@@ -0,0 +1,942 @@
+// Подмножество спецификации GTFS Realtime (https://gtfs.org/realtime/reference/),
+// необходимое для публикации прогнозов прибытия. Имена, номера и типы полей
+// совпадают с gtfs-realtime.proto, поэтому данные читаются любым клиентом GTFS-RT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v6.30.0
+// source: api/proto/gtfs-realtime.proto
+
+package gtfsrt
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FeedHeader_Incrementality int32
+
+const (
+	FeedHeader_FULL_DATASET FeedHeader_Incrementality = 0
+	FeedHeader_DIFFERENTIAL FeedHeader_Incrementality = 1
+)
+
+// Enum value maps for FeedHeader_Incrementality.
+var (
+	FeedHeader_Incrementality_name = map[int32]string{
+		0: "FULL_DATASET",
+		1: "DIFFERENTIAL",
+	}
+	FeedHeader_Incrementality_value = map[string]int32{
+		"FULL_DATASET": 0,
+		"DIFFERENTIAL": 1,
+	}
+)
+
+func (x FeedHeader_Incrementality) Enum() *FeedHeader_Incrementality {
+	p := new(FeedHeader_Incrementality)
+	*p = x
+	return p
+}
+
+func (x FeedHeader_Incrementality) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeedHeader_Incrementality) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_gtfs_realtime_proto_enumTypes[0].Descriptor()
+}
+
+func (FeedHeader_Incrementality) Type() protoreflect.EnumType {
+	return &file_api_proto_gtfs_realtime_proto_enumTypes[0]
+}
+
+func (x FeedHeader_Incrementality) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *FeedHeader_Incrementality) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = FeedHeader_Incrementality(num)
+	return nil
+}
+
+// Deprecated: Use FeedHeader_Incrementality.Descriptor instead.
+func (FeedHeader_Incrementality) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_gtfs_realtime_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type TripUpdate_StopTimeUpdate_ScheduleRelationship int32
+
+const (
+	TripUpdate_StopTimeUpdate_SCHEDULED   TripUpdate_StopTimeUpdate_ScheduleRelationship = 0
+	TripUpdate_StopTimeUpdate_SKIPPED     TripUpdate_StopTimeUpdate_ScheduleRelationship = 1
+	TripUpdate_StopTimeUpdate_NO_DATA     TripUpdate_StopTimeUpdate_ScheduleRelationship = 2
+	TripUpdate_StopTimeUpdate_UNSCHEDULED TripUpdate_StopTimeUpdate_ScheduleRelationship = 3
+)
+
+// Enum value maps for TripUpdate_StopTimeUpdate_ScheduleRelationship.
+var (
+	TripUpdate_StopTimeUpdate_ScheduleRelationship_name = map[int32]string{
+		0: "SCHEDULED",
+		1: "SKIPPED",
+		2: "NO_DATA",
+		3: "UNSCHEDULED",
+	}
+	TripUpdate_StopTimeUpdate_ScheduleRelationship_value = map[string]int32{
+		"SCHEDULED":   0,
+		"SKIPPED":     1,
+		"NO_DATA":     2,
+		"UNSCHEDULED": 3,
+	}
+)
+
+func (x TripUpdate_StopTimeUpdate_ScheduleRelationship) Enum() *TripUpdate_StopTimeUpdate_ScheduleRelationship {
+	p := new(TripUpdate_StopTimeUpdate_ScheduleRelationship)
+	*p = x
+	return p
+}
+
+func (x TripUpdate_StopTimeUpdate_ScheduleRelationship) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TripUpdate_StopTimeUpdate_ScheduleRelationship) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_gtfs_realtime_proto_enumTypes[1].Descriptor()
+}
+
+func (TripUpdate_StopTimeUpdate_ScheduleRelationship) Type() protoreflect.EnumType {
+	return &file_api_proto_gtfs_realtime_proto_enumTypes[1]
+}
+
+func (x TripUpdate_StopTimeUpdate_ScheduleRelationship) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *TripUpdate_StopTimeUpdate_ScheduleRelationship) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = TripUpdate_StopTimeUpdate_ScheduleRelationship(num)
+	return nil
+}
+
+// Deprecated: Use TripUpdate_StopTimeUpdate_ScheduleRelationship.Descriptor instead.
+func (TripUpdate_StopTimeUpdate_ScheduleRelationship) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_gtfs_realtime_proto_rawDescGZIP(), []int{3, 1, 0}
+}
+
+type TripDescriptor_ScheduleRelationship int32
+
+const (
+	TripDescriptor_SCHEDULED   TripDescriptor_ScheduleRelationship = 0
+	TripDescriptor_ADDED       TripDescriptor_ScheduleRelationship = 1
+	TripDescriptor_UNSCHEDULED TripDescriptor_ScheduleRelationship = 2
+	TripDescriptor_CANCELED    TripDescriptor_ScheduleRelationship = 3
+)
+
+// Enum value maps for TripDescriptor_ScheduleRelationship.
+var (
+	TripDescriptor_ScheduleRelationship_name = map[int32]string{
+		0: "SCHEDULED",
+		1: "ADDED",
+		2: "UNSCHEDULED",
+		3: "CANCELED",
+	}
+	TripDescriptor_ScheduleRelationship_value = map[string]int32{
+		"SCHEDULED":   0,
+		"ADDED":       1,
+		"UNSCHEDULED": 2,
+		"CANCELED":    3,
+	}
+)
+
+func (x TripDescriptor_ScheduleRelationship) Enum() *TripDescriptor_ScheduleRelationship {
+	p := new(TripDescriptor_ScheduleRelationship)
+	*p = x
+	return p
+}
+
+func (x TripDescriptor_ScheduleRelationship) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TripDescriptor_ScheduleRelationship) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_gtfs_realtime_proto_enumTypes[2].Descriptor()
+}
+
+func (TripDescriptor_ScheduleRelationship) Type() protoreflect.EnumType {
+	return &file_api_proto_gtfs_realtime_proto_enumTypes[2]
+}
+
+func (x TripDescriptor_ScheduleRelationship) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *TripDescriptor_ScheduleRelationship) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = TripDescriptor_ScheduleRelationship(num)
+	return nil
+}
+
+// Deprecated: Use TripDescriptor_ScheduleRelationship.Descriptor instead.
+func (TripDescriptor_ScheduleRelationship) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_gtfs_realtime_proto_rawDescGZIP(), []int{4, 0}
+}
+
+type FeedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Header        *FeedHeader            `protobuf:"bytes,1,req,name=header" json:"header,omitempty"`
+	Entity        []*FeedEntity          `protobuf:"bytes,2,rep,name=entity" json:"entity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedMessage) Reset() {
+	*x = FeedMessage{}
+	mi := &file_api_proto_gtfs_realtime_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedMessage) ProtoMessage() {}
+
+func (x *FeedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_gtfs_realtime_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedMessage.ProtoReflect.Descriptor instead.
+func (*FeedMessage) Descriptor() ([]byte, []int) {
+	return file_api_proto_gtfs_realtime_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FeedMessage) GetHeader() *FeedHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *FeedMessage) GetEntity() []*FeedEntity {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+type FeedHeader struct {
+	state               protoimpl.MessageState     `protogen:"open.v1"`
+	GtfsRealtimeVersion *string                    `protobuf:"bytes,1,req,name=gtfs_realtime_version,json=gtfsRealtimeVersion" json:"gtfs_realtime_version,omitempty"`
+	Incrementality      *FeedHeader_Incrementality `protobuf:"varint,2,opt,name=incrementality,enum=transit_realtime.FeedHeader_Incrementality,def=0" json:"incrementality,omitempty"`
+	Timestamp           *uint64                    `protobuf:"varint,3,opt,name=timestamp" json:"timestamp,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+// Default values for FeedHeader fields.
+const (
+	Default_FeedHeader_Incrementality = FeedHeader_FULL_DATASET
+)
+
+func (x *FeedHeader) Reset() {
+	*x = FeedHeader{}
+	mi := &file_api_proto_gtfs_realtime_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedHeader) ProtoMessage() {}
+
+func (x *FeedHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_gtfs_realtime_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedHeader.ProtoReflect.Descriptor instead.
+func (*FeedHeader) Descriptor() ([]byte, []int) {
+	return file_api_proto_gtfs_realtime_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FeedHeader) GetGtfsRealtimeVersion() string {
+	if x != nil && x.GtfsRealtimeVersion != nil {
+		return *x.GtfsRealtimeVersion
+	}
+	return ""
+}
+
+func (x *FeedHeader) GetIncrementality() FeedHeader_Incrementality {
+	if x != nil && x.Incrementality != nil {
+		return *x.Incrementality
+	}
+	return Default_FeedHeader_Incrementality
+}
+
+func (x *FeedHeader) GetTimestamp() uint64 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+type FeedEntity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *string                `protobuf:"bytes,1,req,name=id" json:"id,omitempty"`
+	IsDeleted     *bool                  `protobuf:"varint,2,opt,name=is_deleted,json=isDeleted,def=0" json:"is_deleted,omitempty"`
+	TripUpdate    *TripUpdate            `protobuf:"bytes,3,opt,name=trip_update,json=tripUpdate" json:"trip_update,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+// Default values for FeedEntity fields.
+const (
+	Default_FeedEntity_IsDeleted = bool(false)
+)
+
+func (x *FeedEntity) Reset() {
+	*x = FeedEntity{}
+	mi := &file_api_proto_gtfs_realtime_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedEntity) ProtoMessage() {}
+
+func (x *FeedEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_gtfs_realtime_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedEntity.ProtoReflect.Descriptor instead.
+func (*FeedEntity) Descriptor() ([]byte, []int) {
+	return file_api_proto_gtfs_realtime_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FeedEntity) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *FeedEntity) GetIsDeleted() bool {
+	if x != nil && x.IsDeleted != nil {
+		return *x.IsDeleted
+	}
+	return Default_FeedEntity_IsDeleted
+}
+
+func (x *FeedEntity) GetTripUpdate() *TripUpdate {
+	if x != nil {
+		return x.TripUpdate
+	}
+	return nil
+}
+
+type TripUpdate struct {
+	state          protoimpl.MessageState       `protogen:"open.v1"`
+	Trip           *TripDescriptor              `protobuf:"bytes,1,req,name=trip" json:"trip,omitempty"`
+	Vehicle        *VehicleDescriptor           `protobuf:"bytes,3,opt,name=vehicle" json:"vehicle,omitempty"`
+	StopTimeUpdate []*TripUpdate_StopTimeUpdate `protobuf:"bytes,2,rep,name=stop_time_update,json=stopTimeUpdate" json:"stop_time_update,omitempty"`
+	Timestamp      *uint64                      `protobuf:"varint,4,opt,name=timestamp" json:"timestamp,omitempty"`
+	Delay          *int32                       `protobuf:"varint,5,opt,name=delay" json:"delay,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TripUpdate) Reset() {
+	*x = TripUpdate{}
+	mi := &file_api_proto_gtfs_realtime_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripUpdate) ProtoMessage() {}
+
+func (x *TripUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_gtfs_realtime_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripUpdate.ProtoReflect.Descriptor instead.
+func (*TripUpdate) Descriptor() ([]byte, []int) {
+	return file_api_proto_gtfs_realtime_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TripUpdate) GetTrip() *TripDescriptor {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+func (x *TripUpdate) GetVehicle() *VehicleDescriptor {
+	if x != nil {
+		return x.Vehicle
+	}
+	return nil
+}
+
+func (x *TripUpdate) GetStopTimeUpdate() []*TripUpdate_StopTimeUpdate {
+	if x != nil {
+		return x.StopTimeUpdate
+	}
+	return nil
+}
+
+func (x *TripUpdate) GetTimestamp() uint64 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+func (x *TripUpdate) GetDelay() int32 {
+	if x != nil && x.Delay != nil {
+		return *x.Delay
+	}
+	return 0
+}
+
+type TripDescriptor struct {
+	state                protoimpl.MessageState               `protogen:"open.v1"`
+	TripId               *string                              `protobuf:"bytes,1,opt,name=trip_id,json=tripId" json:"trip_id,omitempty"`
+	RouteId              *string                              `protobuf:"bytes,5,opt,name=route_id,json=routeId" json:"route_id,omitempty"`
+	DirectionId          *uint32                              `protobuf:"varint,6,opt,name=direction_id,json=directionId" json:"direction_id,omitempty"`
+	StartTime            *string                              `protobuf:"bytes,2,opt,name=start_time,json=startTime" json:"start_time,omitempty"`
+	StartDate            *string                              `protobuf:"bytes,3,opt,name=start_date,json=startDate" json:"start_date,omitempty"`
+	ScheduleRelationship *TripDescriptor_ScheduleRelationship `protobuf:"varint,4,opt,name=schedule_relationship,json=scheduleRelationship,enum=transit_realtime.TripDescriptor_ScheduleRelationship" json:"schedule_relationship,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TripDescriptor) Reset() {
+	*x = TripDescriptor{}
+	mi := &file_api_proto_gtfs_realtime_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripDescriptor) ProtoMessage() {}
+
+func (x *TripDescriptor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_gtfs_realtime_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripDescriptor.ProtoReflect.Descriptor instead.
+func (*TripDescriptor) Descriptor() ([]byte, []int) {
+	return file_api_proto_gtfs_realtime_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TripDescriptor) GetTripId() string {
+	if x != nil && x.TripId != nil {
+		return *x.TripId
+	}
+	return ""
+}
+
+func (x *TripDescriptor) GetRouteId() string {
+	if x != nil && x.RouteId != nil {
+		return *x.RouteId
+	}
+	return ""
+}
+
+func (x *TripDescriptor) GetDirectionId() uint32 {
+	if x != nil && x.DirectionId != nil {
+		return *x.DirectionId
+	}
+	return 0
+}
+
+func (x *TripDescriptor) GetStartTime() string {
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
+	}
+	return ""
+}
+
+func (x *TripDescriptor) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *TripDescriptor) GetScheduleRelationship() TripDescriptor_ScheduleRelationship {
+	if x != nil && x.ScheduleRelationship != nil {
+		return *x.ScheduleRelationship
+	}
+	return TripDescriptor_SCHEDULED
+}
+
+type VehicleDescriptor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *string                `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Label         *string                `protobuf:"bytes,2,opt,name=label" json:"label,omitempty"`
+	LicensePlate  *string                `protobuf:"bytes,3,opt,name=license_plate,json=licensePlate" json:"license_plate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VehicleDescriptor) Reset() {
+	*x = VehicleDescriptor{}
+	mi := &file_api_proto_gtfs_realtime_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VehicleDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleDescriptor) ProtoMessage() {}
+
+func (x *VehicleDescriptor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_gtfs_realtime_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehicleDescriptor.ProtoReflect.Descriptor instead.
+func (*VehicleDescriptor) Descriptor() ([]byte, []int) {
+	return file_api_proto_gtfs_realtime_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VehicleDescriptor) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *VehicleDescriptor) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *VehicleDescriptor) GetLicensePlate() string {
+	if x != nil && x.LicensePlate != nil {
+		return *x.LicensePlate
+	}
+	return ""
+}
+
+type TripUpdate_StopTimeEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delay         *int32                 `protobuf:"varint,1,opt,name=delay" json:"delay,omitempty"`
+	Time          *int64                 `protobuf:"varint,2,opt,name=time" json:"time,omitempty"`
+	Uncertainty   *int32                 `protobuf:"varint,3,opt,name=uncertainty" json:"uncertainty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripUpdate_StopTimeEvent) Reset() {
+	*x = TripUpdate_StopTimeEvent{}
+	mi := &file_api_proto_gtfs_realtime_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripUpdate_StopTimeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripUpdate_StopTimeEvent) ProtoMessage() {}
+
+func (x *TripUpdate_StopTimeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_gtfs_realtime_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripUpdate_StopTimeEvent.ProtoReflect.Descriptor instead.
+func (*TripUpdate_StopTimeEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_gtfs_realtime_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *TripUpdate_StopTimeEvent) GetDelay() int32 {
+	if x != nil && x.Delay != nil {
+		return *x.Delay
+	}
+	return 0
+}
+
+func (x *TripUpdate_StopTimeEvent) GetTime() int64 {
+	if x != nil && x.Time != nil {
+		return *x.Time
+	}
+	return 0
+}
+
+func (x *TripUpdate_StopTimeEvent) GetUncertainty() int32 {
+	if x != nil && x.Uncertainty != nil {
+		return *x.Uncertainty
+	}
+	return 0
+}
+
+type TripUpdate_StopTimeUpdate struct {
+	state                protoimpl.MessageState                          `protogen:"open.v1"`
+	StopSequence         *uint32                                         `protobuf:"varint,1,opt,name=stop_sequence,json=stopSequence" json:"stop_sequence,omitempty"`
+	StopId               *string                                         `protobuf:"bytes,4,opt,name=stop_id,json=stopId" json:"stop_id,omitempty"`
+	Arrival              *TripUpdate_StopTimeEvent                       `protobuf:"bytes,2,opt,name=arrival" json:"arrival,omitempty"`
+	Departure            *TripUpdate_StopTimeEvent                       `protobuf:"bytes,3,opt,name=departure" json:"departure,omitempty"`
+	ScheduleRelationship *TripUpdate_StopTimeUpdate_ScheduleRelationship `protobuf:"varint,5,opt,name=schedule_relationship,json=scheduleRelationship,enum=transit_realtime.TripUpdate_StopTimeUpdate_ScheduleRelationship,def=0" json:"schedule_relationship,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+// Default values for TripUpdate_StopTimeUpdate fields.
+const (
+	Default_TripUpdate_StopTimeUpdate_ScheduleRelationship = TripUpdate_StopTimeUpdate_SCHEDULED
+)
+
+func (x *TripUpdate_StopTimeUpdate) Reset() {
+	*x = TripUpdate_StopTimeUpdate{}
+	mi := &file_api_proto_gtfs_realtime_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripUpdate_StopTimeUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripUpdate_StopTimeUpdate) ProtoMessage() {}
+
+func (x *TripUpdate_StopTimeUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_gtfs_realtime_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripUpdate_StopTimeUpdate.ProtoReflect.Descriptor instead.
+func (*TripUpdate_StopTimeUpdate) Descriptor() ([]byte, []int) {
+	return file_api_proto_gtfs_realtime_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *TripUpdate_StopTimeUpdate) GetStopSequence() uint32 {
+	if x != nil && x.StopSequence != nil {
+		return *x.StopSequence
+	}
+	return 0
+}
+
+func (x *TripUpdate_StopTimeUpdate) GetStopId() string {
+	if x != nil && x.StopId != nil {
+		return *x.StopId
+	}
+	return ""
+}
+
+func (x *TripUpdate_StopTimeUpdate) GetArrival() *TripUpdate_StopTimeEvent {
+	if x != nil {
+		return x.Arrival
+	}
+	return nil
+}
+
+func (x *TripUpdate_StopTimeUpdate) GetDeparture() *TripUpdate_StopTimeEvent {
+	if x != nil {
+		return x.Departure
+	}
+	return nil
+}
+
+func (x *TripUpdate_StopTimeUpdate) GetScheduleRelationship() TripUpdate_StopTimeUpdate_ScheduleRelationship {
+	if x != nil && x.ScheduleRelationship != nil {
+		return *x.ScheduleRelationship
+	}
+	return Default_TripUpdate_StopTimeUpdate_ScheduleRelationship
+}
+
+var File_api_proto_gtfs_realtime_proto protoreflect.FileDescriptor
+
+var file_api_proto_gtfs_realtime_proto_rawDesc = string([]byte{
+	0x0a, 0x1d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x74, 0x66, 0x73,
+	0x2d, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0x79, 0x0a, 0x0b, 0x46, 0x65, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x34, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xf7, 0x01, 0x0a,
+	0x0a, 0x46, 0x65, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x15, 0x67,
+	0x74, 0x66, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x13, 0x67, 0x74, 0x66, 0x73,
+	0x52, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x61, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x3a, 0x0c, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x53,
+	0x45, 0x54, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x22, 0x34, 0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x53,
+	0x45, 0x54, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x49, 0x46, 0x46, 0x45, 0x52, 0x45, 0x4e,
+	0x54, 0x49, 0x41, 0x4c, 0x10, 0x01, 0x22, 0x81, 0x01, 0x0a, 0x0a, 0x46, 0x65, 0x65, 0x64, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65,
+	0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x74,
+	0x72, 0x69, 0x70, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a,
+	0x74, 0x72, 0x69, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x9f, 0x06, 0x0a, 0x0a, 0x54,
+	0x72, 0x69, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x72, 0x69,
+	0x70, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x04, 0x74, 0x72, 0x69, 0x70, 0x12,
+	0x3d, 0x0a, 0x07, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x55,
+	0x0a, 0x10, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x54, 0x72, 0x69, 0x70,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x73, 0x74, 0x6f, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x1a, 0x5b, 0x0a, 0x0d, 0x53, 0x74, 0x6f,
+	0x70, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69,
+	0x6e, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x75, 0x6e, 0x63, 0x65, 0x72,
+	0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x1a, 0xb3, 0x03, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x70, 0x54,
+	0x69, 0x6d, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x6f,
+	0x70, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x73, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x6f, 0x70, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x54, 0x72, 0x69, 0x70,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x48, 0x0a,
+	0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53,
+	0x74, 0x6f, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x15, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
+	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x40, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x3a, 0x09, 0x53, 0x43, 0x48, 0x45, 0x44,
+	0x55, 0x4c, 0x45, 0x44, 0x52, 0x14, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x22, 0x50, 0x0a, 0x14, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68,
+	0x69, 0x70, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x4e, 0x4f, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x55,
+	0x4e, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x22, 0xe2, 0x02, 0x0a,
+	0x0e, 0x54, 0x72, 0x69, 0x70, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x6a, 0x0a, 0x15, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65,
+	0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x14, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70,
+	0x22, 0x4f, 0x0a, 0x14, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x43, 0x48, 0x45,
+	0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x44, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10,
+	0x03, 0x22, 0x5e, 0x0a, 0x11, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0d,
+	0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x50, 0x6c, 0x61, 0x74,
+	0x65, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x62, 0x61, 0x72, 0x73, 0x34, 0x33, 0x72, 0x75, 0x2f, 0x62, 0x75, 0x73, 0x32, 0x6d, 0x61, 0x70,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x74, 0x66, 0x73, 0x72, 0x74, 0x3b, 0x67, 0x74, 0x66, 0x73,
+	0x72, 0x74,
+})
+
+var (
+	file_api_proto_gtfs_realtime_proto_rawDescOnce sync.Once
+	file_api_proto_gtfs_realtime_proto_rawDescData []byte
+)
+
+func file_api_proto_gtfs_realtime_proto_rawDescGZIP() []byte {
+	file_api_proto_gtfs_realtime_proto_rawDescOnce.Do(func() {
+		file_api_proto_gtfs_realtime_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_gtfs_realtime_proto_rawDesc), len(file_api_proto_gtfs_realtime_proto_rawDesc)))
+	})
+	return file_api_proto_gtfs_realtime_proto_rawDescData
+}
+
+var file_api_proto_gtfs_realtime_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_proto_gtfs_realtime_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_proto_gtfs_realtime_proto_goTypes = []any{
+	(FeedHeader_Incrementality)(0),                      // 0: transit_realtime.FeedHeader.Incrementality
+	(TripUpdate_StopTimeUpdate_ScheduleRelationship)(0), // 1: transit_realtime.TripUpdate.StopTimeUpdate.ScheduleRelationship
+	(TripDescriptor_ScheduleRelationship)(0),            // 2: transit_realtime.TripDescriptor.ScheduleRelationship
+	(*FeedMessage)(nil),                                 // 3: transit_realtime.FeedMessage
+	(*FeedHeader)(nil),                                  // 4: transit_realtime.FeedHeader
+	(*FeedEntity)(nil),                                  // 5: transit_realtime.FeedEntity
+	(*TripUpdate)(nil),                                  // 6: transit_realtime.TripUpdate
+	(*TripDescriptor)(nil),                              // 7: transit_realtime.TripDescriptor
+	(*VehicleDescriptor)(nil),                           // 8: transit_realtime.VehicleDescriptor
+	(*TripUpdate_StopTimeEvent)(nil),                    // 9: transit_realtime.TripUpdate.StopTimeEvent
+	(*TripUpdate_StopTimeUpdate)(nil),                   // 10: transit_realtime.TripUpdate.StopTimeUpdate
+}
+var file_api_proto_gtfs_realtime_proto_depIdxs = []int32{
+	4,  // 0: transit_realtime.FeedMessage.header:type_name -> transit_realtime.FeedHeader
+	5,  // 1: transit_realtime.FeedMessage.entity:type_name -> transit_realtime.FeedEntity
+	0,  // 2: transit_realtime.FeedHeader.incrementality:type_name -> transit_realtime.FeedHeader.Incrementality
+	6,  // 3: transit_realtime.FeedEntity.trip_update:type_name -> transit_realtime.TripUpdate
+	7,  // 4: transit_realtime.TripUpdate.trip:type_name -> transit_realtime.TripDescriptor
+	8,  // 5: transit_realtime.TripUpdate.vehicle:type_name -> transit_realtime.VehicleDescriptor
+	10, // 6: transit_realtime.TripUpdate.stop_time_update:type_name -> transit_realtime.TripUpdate.StopTimeUpdate
+	2,  // 7: transit_realtime.TripDescriptor.schedule_relationship:type_name -> transit_realtime.TripDescriptor.ScheduleRelationship
+	9,  // 8: transit_realtime.TripUpdate.StopTimeUpdate.arrival:type_name -> transit_realtime.TripUpdate.StopTimeEvent
+	9,  // 9: transit_realtime.TripUpdate.StopTimeUpdate.departure:type_name -> transit_realtime.TripUpdate.StopTimeEvent
+	1,  // 10: transit_realtime.TripUpdate.StopTimeUpdate.schedule_relationship:type_name -> transit_realtime.TripUpdate.StopTimeUpdate.ScheduleRelationship
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_gtfs_realtime_proto_init() }
+func file_api_proto_gtfs_realtime_proto_init() {
+	if File_api_proto_gtfs_realtime_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_gtfs_realtime_proto_rawDesc), len(file_api_proto_gtfs_realtime_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_proto_gtfs_realtime_proto_goTypes,
+		DependencyIndexes: file_api_proto_gtfs_realtime_proto_depIdxs,
+		EnumInfos:         file_api_proto_gtfs_realtime_proto_enumTypes,
+		MessageInfos:      file_api_proto_gtfs_realtime_proto_msgTypes,
+	}.Build()
+	File_api_proto_gtfs_realtime_proto = out.File
+	file_api_proto_gtfs_realtime_proto_goTypes = nil
+	file_api_proto_gtfs_realtime_proto_depIdxs = nil
+}
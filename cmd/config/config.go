@@ -2,15 +2,41 @@ package config
 
 import (
 	"log/slog"
+	"time"
+
+	"github.com/bars43ru/bus2map/internal/model/transport_type"
 )
 
 type Config struct {
-	Logger    Logger     `envPrefix:"LOG_"`
-	GRPC      GRPCServer `envPrefix:"GRPC_"`
-	WialonIPS TCPServer  `envPrefix:"WIALON_IPS_"`
-	EGTS      TCPServer  `envPrefix:"EGTS_"`
-	TwoGIS    Yandex     `envPrefix:"TWOGIS_"`
-	Yandex    Yandex     `envPrefix:"YANDEX_"`
+	Logger       Logger       `envPrefix:"LOG_"`
+	GRPC         GRPCServer   `envPrefix:"GRPC_"`
+	HTTP         HTTPServer   `envPrefix:"HTTP_"`
+	State        State        `envPrefix:"STATE_"`
+	Schedule     Schedule     `envPrefix:"SCHEDULE_"`
+	DefaultRoute DefaultRoute `envPrefix:"DEFAULT_ROUTE_"`
+	Overrides    Overrides    `envPrefix:"OVERRIDES_"`
+	Pipeline     Pipeline     `envPrefix:"PIPELINE_"`
+	Dispatcher   Dispatcher   `envPrefix:"DISPATCHER_"`
+	UIDNamespace UIDNamespace `envPrefix:"UID_NAMESPACE_"`
+	ClockSkew    ClockSkew    `envPrefix:"CLOCK_SKEW_"`
+	Trackers     Trackers     `envPrefix:"TRACKERS_"`
+	Validator    Validator    `envPrefix:"VALIDATION_"`
+	Smoothing    Smoothing    `envPrefix:"SMOOTHING_"`
+	Geofence     Geofence     `envPrefix:"GEOFENCE_"`
+	Motion       Motion       `envPrefix:"MOTION_"`
+	Matching     Matching     `envPrefix:"MAP_MATCHING_"`
+	OffRoute     OffRoute     `envPrefix:"OFF_ROUTE_"`
+	Alerts       Alerts       `envPrefix:"ALERTS_"`
+	Diagnostics  Diagnostics  `envPrefix:"DIAGNOSTICS_"`
+	Stops        Stops        `envPrefix:"STOPS_"`
+	Adherence    Adherence    `envPrefix:"ADHERENCE_"`
+	Headway      Headway      `envPrefix:"HEADWAY_"`
+	Missing      Missing      `envPrefix:"MISSING_"`
+	WialonIPS    TCPServer    `envPrefix:"WIALON_IPS_"`
+	EGTS         TCPServer    `envPrefix:"EGTS_"`
+	TwoGIS       Yandex       `envPrefix:"TWOGIS_"`
+	Yandex       Yandex       `envPrefix:"YANDEX_"`
+	Webhooks     []Webhook    `envPrefix:"WEBHOOK_"`
 }
 
 type Logger struct {
@@ -28,9 +54,269 @@ type Yandex struct {
 	Clid     string `env:"CLID,required"`
 	Url      string `env:"URL,required"`
 	Compress bool   `env:"COMPRESS,required"`
+	// Snapped передавать координаты, привязанные к линии маршрута
+	Snapped bool `env:"SNAPPED"`
+	// SendAttempts количество попыток отправки пакета
+	SendAttempts int `env:"SEND_ATTEMPTS" envDefault:"1"`
 }
 
 type GRPCServer struct {
 	ListenAddr    string `env:"LISTEN_ADDR,required"`
 	UseReflection bool   `env:"REFLECTION,required"`
 }
+
+type HTTPServer struct {
+	Enabled    bool   `env:"ENABLED"`
+	ListenAddr string `env:"LISTEN_ADDR" envDefault:":8080"`
+	// OriginPatterns шаблоны хостов страниц, с которых принимаются WebSocket-соединения, например *.example.com
+	OriginPatterns []string `env:"ORIGIN_PATTERNS"`
+}
+
+type State struct {
+	// ActiveWindow время с момента последних данных, в течение которого транспортное средство считается активным
+	ActiveWindow time.Duration `env:"ACTIVE_WINDOW" envDefault:"10m"`
+}
+
+// Schedule допуски при определении расписания транспорта, нулевые значения требуют точного совпадения со сменой
+type Schedule struct {
+	// PreStart допуск до начала смены
+	PreStart time.Duration `env:"PRE_START" envDefault:"0"`
+	// PostEnd допуск после окончания смены
+	PostEnd time.Duration `env:"POST_END" envDefault:"0"`
+	// LastAssignment время, в течение которого используется последнее известное расписание, если текущее не найдено
+	LastAssignment time.Duration `env:"LAST_ASSIGNMENT" envDefault:"0"`
+	// Templates использовать повторяющееся расписание из ./datasource/schedule_template.txt
+	// и производственный календарь из ./datasource/calendar.txt
+	Templates bool `env:"TEMPLATES"`
+	// Timezone часовой пояс повторяющегося расписания и закрепленных маршрутов, например Europe/Moscow
+	Timezone string `env:"TIMEZONE" envDefault:"Local"`
+}
+
+// DefaultRoute маршруты из ./datasource/default_route.txt, закрепленные за транспортом при отсутствии расписания
+type DefaultRoute struct {
+	Enabled bool `env:"ENABLED"`
+}
+
+// Overrides назначения транспорта на маршруты диспетчером через BusTrackingAdminService
+type Overrides struct {
+	Enabled bool `env:"ENABLED"`
+	// File файл хранения назначений
+	File string `env:"FILE" envDefault:"./data/overrides.json"`
+	// AuditLog журнал аудита изменений назначений в формате JSON Lines, пустое значение отключает журнал
+	AuditLog string `env:"AUDIT_LOG" envDefault:"./logs/overrides_audit.log"`
+	// ListenAddr адрес gRPC BusTrackingAdminService, отдельный от публичного GRPC_LISTEN_ADDR
+	ListenAddr string `env:"LISTEN_ADDR" envDefault:"127.0.0.1:50052"`
+	// Operators токены диспетчеров в формате диспетчер:токен
+	Operators map[string]string `env:"OPERATORS"`
+}
+
+// Pipeline конвейер обработки GPS-данных
+type Pipeline struct {
+	// Stages порядок стадий, пустое значение означает порядок по умолчанию
+	Stages []string `env:"STAGES"`
+}
+
+// Dispatcher очереди обработки GPS-данных, распределенные по UID трекера
+type Dispatcher struct {
+	Enabled bool `env:"ENABLED"`
+	// Workers количество очередей и обработчиков
+	Workers   int `env:"WORKERS" envDefault:"8"`
+	QueueSize int `env:"QUEUE_SIZE" envDefault:"1000"`
+	// Overflow поведение при заполненной очереди: block - ожидать места, drop - отбросить данные
+	Overflow string `env:"OVERFLOW" envDefault:"block"`
+}
+
+// UIDNamespace пространства имен UID трекеров по приемникам
+type UIDNamespace struct {
+	Enabled bool `env:"ENABLED"`
+	// Legacy искать трекер в справочнике транспорта по исходному UID, если UID с пространством имен не найден;
+	// используется на время перевода справочника на UID с пространством имен
+	Legacy    bool         `env:"LEGACY"`
+	WialonIPS UIDTransform `envPrefix:"WIALON_IPS_"`
+	EGTS      UIDTransform `envPrefix:"EGTS_"`
+	GRPC      UIDTransform `envPrefix:"GRPC_"`
+}
+
+// UIDTransform преобразование UID трекеров приемника
+type UIDTransform struct {
+	// Prefix не должен содержать ':', разделяющий UID и поправку в CLOCK_SKEW_OFFSETS
+	Prefix string `env:"PREFIX"`
+	// Pattern регулярное выражение, по которому заменяется UID, пустое значение отключает замену
+	Pattern string `env:"PATTERN"`
+	// Replace шаблон замены, например $1 для первой группы
+	Replace    string `env:"REPLACE"`
+	StripZeros bool   `env:"STRIP_ZEROS"`
+}
+
+// ClockSkew оценка и исправление расхождения часов трекеров со временем сервера
+type ClockSkew struct {
+	Enabled bool `env:"ENABLED"`
+	// Window окно сообщений, по которым оценивается расхождение
+	Window time.Duration `env:"WINDOW" envDefault:"10m"`
+	// MinSamples минимальное количество сообщений в окне для автоматической поправки
+	MinSamples int `env:"MIN_SAMPLES" envDefault:"5"`
+	// Threshold расхождение, начиная с которого время исправляется автоматически
+	Threshold time.Duration `env:"THRESHOLD" envDefault:"2m"`
+	// Auto исправлять время по оценке расхождения
+	Auto bool `env:"AUTO"`
+	// Offsets поправки для отдельных трекеров в формате UID:поправка, например 353173067906170:-3h
+	Offsets map[string]time.Duration `env:"OFFSETS"`
+}
+
+// Trackers объединение данных нескольких трекеров одного транспорта
+type Trackers struct {
+	Enabled bool `env:"ENABLED"`
+	// Silence молчание активного трекера, после которого публикуются данные резервного
+	Silence time.Duration `env:"SILENCE" envDefault:"1m"`
+}
+
+// Validator правила проверки входящих GPS-данных, нулевое значение правила отключает его
+type Validator struct {
+	Enabled       bool          `env:"ENABLED"`
+	MaxFutureSkew time.Duration `env:"MAX_FUTURE_SKEW" envDefault:"5m"`
+	MaxPastSkew   time.Duration `env:"MAX_PAST_SKEW" envDefault:"1h"`
+	MaxSpeed      float64       `env:"MAX_SPEED" envDefault:"150"`
+	SpeedWindow   time.Duration `env:"SPEED_WINDOW" envDefault:"10m"`
+	// Area область обслуживания в формате min_lon,min_lat,max_lon,max_lat
+	Area []float64 `env:"AREA"`
+}
+
+// Smoothing параметры подавления дрожания координат на стоянке и сглаживания трека
+type Smoothing struct {
+	Enabled          bool          `env:"ENABLED"`
+	StationarySpeed  uint32        `env:"STATIONARY_SPEED" envDefault:"3"`
+	StationaryRadius float64       `env:"STATIONARY_RADIUS" envDefault:"30"`
+	StationaryWindow time.Duration `env:"STATIONARY_WINDOW" envDefault:"1m"`
+	Kalman           bool          `env:"KALMAN"`
+	KalmanAccuracy   float64       `env:"KALMAN_ACCURACY" envDefault:"15"`
+	KalmanNoise      float64       `env:"KALMAN_NOISE" envDefault:"3"`
+	ResetAfter       time.Duration `env:"RESET_AFTER" envDefault:"5m"`
+}
+
+// Geofence настройки геозон из ./datasource/geofence.geojson
+type Geofence struct {
+	Enabled bool `env:"ENABLED"`
+	// SuppressTypes типы геозон, данные из которых не публикуются
+	SuppressTypes []string `env:"SUPPRESS_TYPES" envDefault:"depot,no_publish"`
+	// SuppressOutside не публиковать данные вне всех областей обслуживания (service_area)
+	SuppressOutside bool `env:"SUPPRESS_OUTSIDE_SERVICE_AREA"`
+}
+
+// Motion правила вычисления курса и скорости по соседним точкам.
+// Режимы: off - данные трекера, fill - замена нулевых значений, override - всегда вычисленные значения
+type Motion struct {
+	Enabled bool   `env:"ENABLED"`
+	Default string `env:"DEFAULT" envDefault:"fill"`
+	// Режимы для отдельных транспортных средств в формате госномер:режим
+	Vehicles map[string]string `env:"VEHICLES"`
+	// Режимы для типов транспорта в формате тип:режим
+	TransportTypes map[string]string `env:"TRANSPORT_TYPES"`
+	// Режимы для приемников (wialon_ips, egts, grpc) в формате приемник:режим
+	Receivers   map[string]string `env:"RECEIVERS"`
+	MinDistance float64           `env:"MIN_DISTANCE" envDefault:"15"`
+	MaxInterval time.Duration     `env:"MAX_INTERVAL" envDefault:"2m"`
+}
+
+// Matching настройки привязки к геометрии маршрутов из ./datasource/shapes
+type Matching struct {
+	Enabled bool `env:"ENABLED"`
+	// MaxOffset максимальное отклонение от линии маршрута в метрах, при котором точка считается привязанной
+	MaxOffset float64 `env:"MAX_OFFSET" envDefault:"50"`
+	// Tolerance допуск в метрах при выборе участка маршрута рядом с предыдущим положением
+	Tolerance float64 `env:"TOLERANCE" envDefault:"20"`
+}
+
+// OffRoute правила определения схода с маршрута, работает при включенной привязке к маршрутам
+type OffRoute struct {
+	Enabled bool `env:"ENABLED"`
+	// Distance отклонение от линии маршрута в метрах, при превышении которого дольше Duration возникает событие
+	Distance float64       `env:"DISTANCE" envDefault:"150"`
+	Duration time.Duration `env:"DURATION" envDefault:"1m"`
+	// ClearDistance отклонение в метрах, при возврате в пределы которого дольше ClearDuration событие завершается
+	ClearDistance float64       `env:"CLEAR_DISTANCE" envDefault:"50"`
+	ClearDuration time.Duration `env:"CLEAR_DURATION" envDefault:"30s"`
+}
+
+// Alerts настройки хранения событий для диспетчера
+type Alerts struct {
+	// LogFile журнал событий в формате JSON Lines, пустое значение отключает журнал
+	LogFile string `env:"LOG_FILE" envDefault:"./logs/alerts.log"`
+	// History количество последних изменений событий, хранимых в памяти
+	History int `env:"HISTORY" envDefault:"1000"`
+}
+
+// Diagnostics хранение сведений о GPS-данных, не сопоставленных со справочниками
+type Diagnostics struct {
+	// TTL время, после которого сведения о неповторявшейся проблеме удаляются
+	TTL time.Duration `env:"TTL" envDefault:"24h"`
+	// Capacity наибольшее количество хранимых записей
+	Capacity int `env:"CAPACITY" envDefault:"10000"`
+}
+
+// Stops настройки определения прибытия на остановки из ./datasource/stops.txt и прогноза прибытия
+type Stops struct {
+	Enabled bool `env:"ENABLED"`
+	// Radius расстояние до остановки в метрах, в пределах которого транспорт находится на ней
+	Radius float64 `env:"RADIUS" envDefault:"30"`
+	// History количество последних измерений времени движения между остановками, по которым строится прогноз
+	History int `env:"HISTORY" envDefault:"5"`
+	// DefaultSpeed скорость в км/ч для участков между остановками, по которым еще нет измерений
+	DefaultSpeed float64 `env:"DEFAULT_SPEED" envDefault:"20"`
+	// DefaultDwell время стоянки на остановке, по которой еще нет измерений
+	DefaultDwell time.Duration `env:"DEFAULT_DWELL" envDefault:"20s"`
+}
+
+// Adherence пороги соблюдения расписания движения по остановкам из ./datasource/timetable.txt
+type Adherence struct {
+	Enabled bool `env:"ENABLED"`
+	// Early допустимое опережение расписания
+	Early time.Duration `env:"EARLY" envDefault:"1m"`
+	// Late допустимое опоздание
+	Late time.Duration `env:"LATE" envDefault:"5m"`
+	// MaxDeviation отклонение, при превышении которого рейс расписания не сопоставляется с транспортом
+	MaxDeviation time.Duration `env:"MAX_DEVIATION" envDefault:"30m"`
+}
+
+// Headway правила контроля интервалов движения, работает при включенной привязке к маршрутам
+type Headway struct {
+	Enabled bool `env:"ENABLED"`
+	// Planned плановый интервал для маршрутов, не указанных в Routes
+	Planned time.Duration `env:"PLANNED" envDefault:"10m"`
+	// Routes плановые интервалы маршрутов в формате маршрут:интервал
+	Routes map[string]time.Duration `env:"ROUTES"`
+	// BunchingRatio доля планового интервала, меньше которой фиксируется сближение
+	BunchingRatio float64 `env:"BUNCHING_RATIO" envDefault:"0.3"`
+	// GapRatio доля планового интервала, больше которой фиксируется разрыв
+	GapRatio float64 `env:"GAP_RATIO" envDefault:"1.8"`
+	// History время хранения пройденного пути транспорта для вычисления интервала
+	History time.Duration `env:"HISTORY" envDefault:"2h"`
+}
+
+// Missing правила выявления транспорта, который должен работать по расписанию, но не передает данные
+type Missing struct {
+	Enabled bool `env:"ENABLED"`
+	// LateStart время от начала смены без данных, после которого фиксируется опоздание с выходом на линию
+	LateStart time.Duration `env:"LATE_START" envDefault:"10m"`
+	// NotReporting время от начала смены без данных, после которого транспорт считается не вышедшим на связь
+	NotReporting time.Duration `env:"NOT_REPORTING" envDefault:"30m"`
+	// Silent время без данных во время смены, после которого транспорт считается пропавшим со связи
+	Silent time.Duration `env:"SILENT" envDefault:"5m"`
+	// Interval периодичность проверки
+	Interval time.Duration `env:"INTERVAL" envDefault:"1m"`
+}
+
+// Webhook настройки отправки данных сторонним интеграторам по HTTP.
+// Задаются списком с индексом в имени переменной: WEBHOOK_0_URL, WEBHOOK_1_URL и т.д.
+type Webhook struct {
+	Enabled         bool                  `env:"ENABLED"`
+	Url             string                `env:"URL,required"`
+	Method          string                `env:"METHOD" envDefault:"POST"`
+	Headers         map[string]string     `env:"HEADERS"`
+	HMACSecret      string                `env:"HMAC_SECRET"`
+	HMACHeader      string                `env:"HMAC_HEADER" envDefault:"X-Signature"`
+	TemplateFile    string                `env:"TEMPLATE_FILE,required"`
+	Batch           bool                  `env:"BATCH"`
+	SendAttempts    int                   `env:"SEND_ATTEMPTS" envDefault:"3"`
+	FilterRoutes    []string              `env:"FILTER_ROUTES"`
+	FilterTransport []transport_type.Type `env:"FILTER_TRANSPORT_TYPES"`
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/caarlos0/env/v11"
@@ -11,5 +12,23 @@ func New() (Config, error) {
 	if err != nil {
 		return config, fmt.Errorf("parse env: %w", err)
 	}
+	if err := config.validate(); err != nil {
+		return config, err
+	}
 	return config, err
 }
+
+// validate проверяет, что функции, зависящие от других функций, включены вместе с ними.
+func (c Config) validate() error {
+	var errs []error
+	if c.OffRoute.Enabled && !c.Matching.Enabled {
+		errs = append(errs, errors.New("OFF_ROUTE_ENABLED requires MAP_MATCHING_ENABLED"))
+	}
+	if c.Headway.Enabled && !c.Matching.Enabled {
+		errs = append(errs, errors.New("HEADWAY_ENABLED requires MAP_MATCHING_ENABLED"))
+	}
+	if c.Adherence.Enabled && !c.Stops.Enabled {
+		errs = append(errs, errors.New("ADHERENCE_ENABLED requires STOPS_ENABLED"))
+	}
+	return errors.Join(errs...)
+}
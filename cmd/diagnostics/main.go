@@ -0,0 +1,100 @@
+// Команда diagnostics выгружает из работающего сервиса список GPS-данных, не сопоставленных
+// со справочниками, в формате, удобном для исправления transport.txt и schedule.txt.
+//
+//	go run ./cmd/diagnostics -addr localhost:9090 -kind unknown_uid
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pb "github.com/bars43ru/bus2map/api/bustracking"
+	"github.com/bars43ru/bus2map/pkg/xslog"
+)
+
+// transportStatePlaceholder заполнитель госномера в заготовке строк transport.txt
+const transportStatePlaceholder = "ГОСНОМЕР"
+
+var _KindNames = map[string]pb.Diagnostic_Kind{
+	"unknown_uid": pb.Diagnostic_UNKNOWN_UID,
+	"no_schedule": pb.Diagnostic_NO_SCHEDULE,
+	"no_route":    pb.Diagnostic_NO_ROUTE,
+}
+
+func main() {
+	addr := flag.String("addr", "localhost:9090", "адрес gRPC сервера")
+	kinds := flag.String("kind", "", "виды проблем через запятую: unknown_uid, no_schedule, no_route")
+	transport := flag.Bool("transport", false, "выгрузить неизвестные UID заготовкой строк для transport.txt")
+	flag.Parse()
+
+	if err := run(*addr, *kinds, *transport); err != nil {
+		slog.Error("export diagnostics", xslog.Error(err))
+		os.Exit(-1)
+	}
+}
+
+func run(addr string, kinds string, transport bool) error {
+	req := &pb.ListDiagnosticsRequest{}
+	if transport {
+		kinds = "unknown_uid"
+	}
+	for _, name := range strings.Split(kinds, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		kind, ok := _KindNames[name]
+		if !ok {
+			return fmt.Errorf("unexpected kind `%s`", name)
+		}
+		req.Kinds = append(req.Kinds, kind)
+	}
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("connect %s: %w", addr, err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	resp, err := pb.NewBusTrackingServiceClient(conn).ListDiagnostics(ctx, req)
+	if err != nil {
+		return fmt.Errorf("list diagnostics: %w", err)
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+	if transport {
+		// uid;state;type - госномер вместо заполнителя и тип указывает диспетчер
+		for _, item := range resp.GetItems() {
+			_, _ = fmt.Fprintf(w, "%s;%s;bus\n", item.GetUid(), transportStatePlaceholder)
+		}
+		return nil
+	}
+	_, _ = fmt.Fprintln(w, "kind;uid;state_number;route_number;first_seen;last_seen;count;latitude;longitude;receiver")
+	for _, item := range resp.GetItems() {
+		gps := item.GetLastGpsData()
+		_, _ = fmt.Fprintf(w, "%s;%s;%s;%s;%s;%s;%d;%f;%f;%s\n",
+			strings.ToLower(item.GetKind().String()),
+			item.GetUid(),
+			item.GetStateNumber(),
+			item.GetRouteNumber(),
+			item.GetFirstSeen().AsTime().Format(time.RFC3339),
+			item.GetLastSeen().AsTime().Format(time.RFC3339),
+			item.GetCount(),
+			gps.GetLatitude(),
+			gps.GetLongitude(),
+			gps.GetReceiver(),
+		)
+	}
+	return nil
+}
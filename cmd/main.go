@@ -3,12 +3,18 @@ package main
 import (
 	"context"
 	"errors"
+	"expvar"
 	"fmt"
+	"io"
 	"log/slog"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"regexp"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/natefinch/lumberjack"
@@ -19,11 +25,15 @@ import (
 	pb "github.com/bars43ru/bus2map/api/bustracking"
 	"github.com/bars43ru/bus2map/cmd/config"
 	"github.com/bars43ru/bus2map/internal/controller"
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/model/transport_type"
+	"github.com/bars43ru/bus2map/internal/protocols/webhook"
 	"github.com/bars43ru/bus2map/internal/protocols/yandex"
 	"github.com/bars43ru/bus2map/internal/receiver"
 	"github.com/bars43ru/bus2map/internal/repository"
 	"github.com/bars43ru/bus2map/internal/sender"
 	"github.com/bars43ru/bus2map/internal/service"
+	"github.com/bars43ru/bus2map/pkg/geo"
 	"github.com/bars43ru/bus2map/pkg/tcp"
 	"github.com/bars43ru/bus2map/pkg/xslog"
 )
@@ -62,15 +72,175 @@ func main() {
 	}()
 
 	routeRepository := repository.NewRoute(repository.FileDatasourceRoute)
-	scheduleRepository := repository.NewSchedule(repository.FileDatasourceSchedule)
+	location, err := time.LoadLocation(cfg.Schedule.Timezone)
+	if err != nil {
+		slog.Error("load schedule timezone", xslog.Error(err))
+		return
+	}
+	var scheduleTemplateRepository *repository.ScheduleTemplate
+	if cfg.Schedule.Templates {
+		scheduleTemplateRepository = repository.NewScheduleTemplate(
+			repository.FileDatasourceScheduleTemplate,
+			repository.FileDatasourceCalendar,
+			location,
+		)
+	}
+	scheduleRepository := repository.NewSchedule(repository.FileDatasourceSchedule, scheduleTemplateRepository)
 	transportRepository := repository.NewTransport(repository.FileDatasourceTransport)
-	busTracking := service.New(routeRepository, transportRepository, scheduleRepository)
+	vehicleStateRepository := repository.NewVehicleState()
+	diagnosticsRepository := repository.NewDiagnostics(cfg.Diagnostics.TTL, cfg.Diagnostics.Capacity)
+	alertsRepository := repository.NewAlerts(NewJournal(cfg.Alerts.LogFile), cfg.Alerts.History)
 
 	var workers []Workers
 	workers = append(workers, routeRepository, scheduleRepository, transportRepository)
+	if scheduleTemplateRepository != nil {
+		workers = append(workers, scheduleTemplateRepository)
+	}
+
+	var geofencing *service.Geofencing
+	if cfg.Geofence.Enabled {
+		geofenceRepository := repository.NewGeofence(repository.FileDatasourceGeofence)
+		suppress := make([]model.GeofenceType, 0, len(cfg.Geofence.SuppressTypes))
+		for _, t := range cfg.Geofence.SuppressTypes {
+			suppress = append(suppress, model.GeofenceType(t))
+		}
+		geofencing = service.NewGeofencing(geofenceRepository, suppress, cfg.Geofence.SuppressOutside)
+		workers = append(workers, geofenceRepository)
+	}
+
+	var matcher *service.MapMatcher
+	if cfg.Matching.Enabled {
+		routeShapeRepository := repository.NewRouteShape(repository.DirDatasourceRouteShape)
+		matcher = service.NewMapMatcher(routeShapeRepository, cfg.Matching.MaxOffset, cfg.Matching.Tolerance)
+		workers = append(workers, routeShapeRepository)
+	}
+
+	var offRoute *service.OffRouteDetector
+	if cfg.OffRoute.Enabled {
+		offRoute = service.NewOffRouteDetector(service.OffRouteRules{
+			Distance:      cfg.OffRoute.Distance,
+			Duration:      cfg.OffRoute.Duration,
+			ClearDistance: cfg.OffRoute.ClearDistance,
+			ClearDuration: cfg.OffRoute.ClearDuration,
+			Timeout:       cfg.State.ActiveWindow,
+		})
+	}
+
+	var stops *service.StopTracker
+	if cfg.Stops.Enabled {
+		stopRepository := repository.NewStop(repository.FileDatasourceStop)
+		stops = service.NewStopTracker(stopRepository, service.StopRules{
+			Radius:       cfg.Stops.Radius,
+			History:      cfg.Stops.History,
+			DefaultSpeed: cfg.Stops.DefaultSpeed,
+			DefaultDwell: cfg.Stops.DefaultDwell,
+		})
+		workers = append(workers, stopRepository)
+	}
+
+	var adherence *service.ScheduleAdherence
+	if cfg.Adherence.Enabled {
+		timetableRepository := repository.NewTimetable(repository.FileDatasourceTimetable)
+		adherence = service.NewScheduleAdherence(timetableRepository, service.AdherenceRules{
+			Early:        cfg.Adherence.Early,
+			Late:         cfg.Adherence.Late,
+			MaxDeviation: cfg.Adherence.MaxDeviation,
+		})
+		workers = append(workers, timetableRepository)
+	}
+
+	headway := NewHeadwayMonitor(cfg.Headway, cfg.State.ActiveWindow)
+
+	var defaultRouteRepository *repository.DefaultRoute
+	if cfg.DefaultRoute.Enabled {
+		defaultRouteRepository = repository.NewDefaultRoute(repository.FileDatasourceDefaultRoute, location)
+		workers = append(workers, defaultRouteRepository)
+	}
+	var overrideRepository *repository.Override
+	if cfg.Overrides.Enabled {
+		if len(cfg.Overrides.Operators) == 0 {
+			slog.Error("schedule overrides require operator tokens, set OVERRIDES_OPERATORS")
+			return
+		}
+		overrideRepository, err = repository.NewOverride(cfg.Overrides.File, NewJournal(cfg.Overrides.AuditLog))
+		if err != nil {
+			slog.Error("new overrides", xslog.Error(err))
+			return
+		}
+	}
+	scheduleResolver := NewScheduleResolver(cfg.Schedule, scheduleRepository, defaultRouteRepository)
+
+	validator, err := NewValidator(cfg.Validator)
+	if err != nil {
+		slog.Error("new validator", xslog.Error(err))
+		return
+	}
+	motion, err := NewMotionEstimator(cfg.Motion)
+	if err != nil {
+		slog.Error("new motion estimator", xslog.Error(err))
+		return
+	}
+	uidNamespaces, err := NewUIDNamespaces(cfg.UIDNamespace)
+	if err != nil {
+		slog.Error("new uid namespaces", xslog.Error(err))
+		return
+	}
+	missing, err := NewMissingVehicleDetector(cfg.Missing, scheduleRepository, overrideRepository, vehicleStateRepository)
+	if err != nil {
+		slog.Error("new missing vehicle detector", xslog.Error(err))
+		return
+	}
+	busTracking := service.New(service.Options{
+		Route:       routeRepository,
+		Transport:   transportRepository,
+		Schedule:    scheduleRepository,
+		State:       vehicleStateRepository,
+		Diagnostics: diagnosticsRepository,
+		Alerts:      alertsRepository,
+		Overrides:   overrideRepository,
+		Validator:   validator,
+		Smoother:    NewSmoother(cfg.Smoothing),
+		Geofence:    geofencing,
+		Motion:      motion,
+		Matcher:     matcher,
+		OffRoute:    offRoute,
+		Stops:       stops,
+		Adherence:   adherence,
+		Headway:     headway,
+		Missing:     missing,
+		Resolver:    scheduleResolver,
+		Clock:       NewClockSkewEstimator(cfg.ClockSkew),
+		Merger:      NewTrackerMerger(cfg.Trackers),
+		UIDs:        uidNamespaces,
+	})
+	if len(cfg.Pipeline.Stages) != 0 {
+		pipeline, err := busTracking.NewPipeline(cfg.Pipeline.Stages)
+		if err != nil {
+			slog.Error("new pipeline", xslog.Error(err))
+			return
+		}
+		busTracking.UsePipeline(pipeline)
+		slog.InfoContext(ctx, "gps data pipeline", slog.Any("stages", pipeline.Stages()))
+	}
+	if cfg.OffRoute.Enabled || cfg.Headway.Enabled {
+		workers = append(workers, WorkerFn(busTracking.WatchStaleAlerts))
+	}
+	if cfg.Missing.Enabled {
+		workers = append(workers, WorkerFn(busTracking.WatchMissingVehicles))
+	}
+	var gpsLocator receiver.GPSLocator = busTracking
+	if cfg.Dispatcher.Enabled {
+		dispatcher, err := NewDispatcher(cfg.Dispatcher, busTracking)
+		if err != nil {
+			slog.Error("new dispatcher", xslog.Error(err))
+			return
+		}
+		gpsLocator = dispatcher
+		workers = append(workers, dispatcher)
+	}
 
 	if cfg.WialonIPS.Enabled {
-		bridgeWialonIPS := receiver.BridgeWialonIPS(busTracking)
+		bridgeWialonIPS := receiver.BridgeWialonIPS(gpsLocator)
 		tpcServer, err := tcp.New(cfg.WialonIPS.Addr, bridgeWialonIPS)
 		if err != nil {
 			slog.Error("close connection with wialon ips", xslog.Error(err))
@@ -80,7 +250,7 @@ func main() {
 	}
 
 	if cfg.EGTS.Enabled {
-		bridgeEGTSIPS := receiver.BridgeEGTS(busTracking)
+		bridgeEGTSIPS := receiver.BridgeEGTS(gpsLocator)
 		tpcServer, err := tcp.New(cfg.EGTS.Addr, bridgeEGTSIPS)
 		if err != nil {
 			slog.Error("close connection with egts", xslog.Error(err))
@@ -91,28 +261,66 @@ func main() {
 
 	if cfg.Yandex.Enabled {
 		cli := yandex.New(cfg.Yandex.Clid, cfg.Yandex.Url, cfg.Yandex.Compress)
-		worker := sender.BridgeYandex(cli, busTracking.SubscribeLocation())
+		worker := sender.BridgeYandex(cli, cfg.Yandex.Snapped, cfg.Yandex.SendAttempts, busTracking.SubscribeLocation())
 		workers = append(workers, WorkerFn(worker))
 	}
 
 	if cfg.TwoGIS.Enabled {
 		cli := yandex.New(cfg.TwoGIS.Clid, cfg.TwoGIS.Url, cfg.TwoGIS.Compress)
-		worker := sender.BridgeYandex(cli, busTracking.SubscribeLocation())
+		worker := sender.BridgeYandex(cli, cfg.TwoGIS.Snapped, cfg.TwoGIS.SendAttempts, busTracking.SubscribeLocation())
+		workers = append(workers, WorkerFn(worker))
+	}
+
+	for _, cfgWebhook := range cfg.Webhooks {
+		if !cfgWebhook.Enabled {
+			continue
+		}
+		tmpl, err := webhook.ParseTemplateFile(cfgWebhook.TemplateFile)
+		if err != nil {
+			slog.Error("load webhook template", xslog.Error(err))
+			return
+		}
+		cli := webhook.New(
+			cfgWebhook.Url,
+			cfgWebhook.Method,
+			cfgWebhook.Headers,
+			cfgWebhook.HMACSecret,
+			cfgWebhook.HMACHeader,
+		)
+		filter := sender.Filter{TransportTypes: cfgWebhook.FilterTransport}
+		for _, number := range cfgWebhook.FilterRoutes {
+			filter.Routes = append(filter.Routes, model.RouteNumber(number))
+		}
+		worker := sender.BridgeWebhook(cli, tmpl, cfgWebhook.Batch, cfgWebhook.SendAttempts, filter, busTracking.SubscribeLocation())
 		workers = append(workers, WorkerFn(worker))
 	}
 
-	facade := service.New(
-		routeRepository,
-		transportRepository,
-		scheduleRepository,
-	)
 	grpcSrv := grpc.NewServer()
-	grpcCtrl := controller.NewBusTrackingService(facade)
+	grpcCtrl := controller.NewBusTrackingService(busTracking, gpsLocator, cfg.State.ActiveWindow)
 	pb.RegisterBusTrackingServiceServer(grpcSrv, grpcCtrl)
 	if cfg.GRPC.UseReflection {
 		reflection.Register(grpcSrv)
 	}
 	workers = append(workers, NewGRPCSrv(grpcSrv, cfg.GRPC.ListenAddr))
+	if cfg.Overrides.Enabled {
+		adminSrv := grpc.NewServer(grpc.UnaryInterceptor(controller.AdminAuth(cfg.Overrides.Operators)))
+		pb.RegisterBusTrackingAdminServiceServer(adminSrv, controller.NewAdminService(busTracking))
+		if cfg.GRPC.UseReflection {
+			reflection.Register(adminSrv)
+		}
+		workers = append(workers, NewGRPCSrv(adminSrv, cfg.Overrides.ListenAddr))
+	}
+
+	if cfg.HTTP.Enabled {
+		mux := http.NewServeMux()
+		mux.Handle("GET /debug/vars", expvar.Handler())
+		controller.NewLiveFeed(busTracking, cfg.State.ActiveWindow, cfg.HTTP.OriginPatterns).Register(mux)
+		controller.NewFleetSnapshot(busTracking, cfg.State.ActiveWindow).Register(mux)
+		if cfg.Stops.Enabled {
+			controller.NewGTFSRealtime(busTracking, cfg.State.ActiveWindow).Register(mux)
+		}
+		workers = append(workers, NewHTTPSrv(&http.Server{Addr: cfg.HTTP.ListenAddr, Handler: mux}))
+	}
 
 	group, ctxGroup := errgroup.WithContext(ctx)
 	for _, w := range workers {
@@ -152,6 +360,234 @@ func NewGRPCSrv(grpcSrv *grpc.Server, address string) WorkerFn {
 	}
 }
 
+func NewValidator(cfg config.Validator) (*service.Validator, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	rules := service.ValidationRules{
+		MaxFutureSkew: cfg.MaxFutureSkew,
+		MaxPastSkew:   cfg.MaxPastSkew,
+		MaxSpeed:      cfg.MaxSpeed,
+		SpeedWindow:   cfg.SpeedWindow,
+	}
+	if len(cfg.Area) != 0 {
+		if len(cfg.Area) != 4 {
+			return nil, fmt.Errorf("area must contain 4 values: min_lon,min_lat,max_lon,max_lat")
+		}
+		rules.Area = &geo.BBox{
+			Min: geo.Point{Longitude: cfg.Area[0], Latitude: cfg.Area[1]},
+			Max: geo.Point{Longitude: cfg.Area[2], Latitude: cfg.Area[3]},
+		}
+	}
+	return service.NewValidator(rules), nil
+}
+
+func NewSmoother(cfg config.Smoothing) *service.Smoother {
+	if !cfg.Enabled {
+		return nil
+	}
+	return service.NewSmoother(service.SmoothingRules{
+		StationarySpeed:  cfg.StationarySpeed,
+		StationaryRadius: cfg.StationaryRadius,
+		StationaryWindow: cfg.StationaryWindow,
+		Kalman:           cfg.Kalman,
+		KalmanAccuracy:   cfg.KalmanAccuracy,
+		KalmanNoise:      cfg.KalmanNoise,
+		ResetAfter:       cfg.ResetAfter,
+	})
+}
+
+func NewMotionEstimator(cfg config.Motion) (*service.MotionEstimator, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	policy := service.MotionPolicy{
+		Vehicles:       make(map[model.StateNumber]service.MotionMode, len(cfg.Vehicles)),
+		TransportTypes: make(map[transport_type.Type]service.MotionMode, len(cfg.TransportTypes)),
+		Receivers:      make(map[model.Receiver]service.MotionMode, len(cfg.Receivers)),
+		MinDistance:    cfg.MinDistance,
+		MaxInterval:    cfg.MaxInterval,
+	}
+	var err error
+	if policy.Default, err = service.ParseMotionMode(cfg.Default); err != nil {
+		return nil, err
+	}
+	for stateNumber, mode := range cfg.Vehicles {
+		if policy.Vehicles[model.StateNumber(stateNumber)], err = service.ParseMotionMode(mode); err != nil {
+			return nil, err
+		}
+	}
+	for name, mode := range cfg.TransportTypes {
+		t, err := transport_type.ParseType(name)
+		if err != nil {
+			return nil, fmt.Errorf("parse transport type: %w", err)
+		}
+		if policy.TransportTypes[t], err = service.ParseMotionMode(mode); err != nil {
+			return nil, err
+		}
+	}
+	for receiver, mode := range cfg.Receivers {
+		if policy.Receivers[model.Receiver(receiver)], err = service.ParseMotionMode(mode); err != nil {
+			return nil, err
+		}
+	}
+	return service.NewMotionEstimator(policy), nil
+}
+
+func NewHTTPSrv(httpSrv *http.Server) WorkerFn {
+	return func(ctx context.Context) error {
+		go func() {
+			<-ctx.Done()
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+			defer cancel()
+			if err := httpSrv.Shutdown(ctx); err != nil {
+				slog.ErrorContext(ctx, "shutdown http server", xslog.Error(err))
+			}
+		}()
+		err := httpSrv.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("listen and serve http %s: %w", httpSrv.Addr, err)
+		}
+		slog.InfoContext(ctx, "http server has gracefully shutdown.")
+		return nil
+	}
+}
+
+func NewHeadwayMonitor(cfg config.Headway, activeWindow time.Duration) *service.HeadwayMonitor {
+	if !cfg.Enabled {
+		return nil
+	}
+	rules := service.HeadwayRules{
+		Default:       cfg.Planned,
+		Routes:        make(map[model.RouteNumber]time.Duration, len(cfg.Routes)),
+		BunchingRatio: cfg.BunchingRatio,
+		GapRatio:      cfg.GapRatio,
+		ActiveWindow:  activeWindow,
+		History:       cfg.History,
+	}
+	for number, planned := range cfg.Routes {
+		rules.Routes[model.RouteNumber(number)] = planned
+	}
+	return service.NewHeadwayMonitor(rules)
+}
+
+// NewMissingVehicleDetector возвращает детектор отсутствующего транспорта или nil, если он отключен.
+func NewMissingVehicleDetector(
+	cfg config.Missing,
+	schedule *repository.Schedule,
+	overrides *repository.Override,
+	state *repository.VehicleState,
+) (*service.MissingVehicleDetector, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	if cfg.Interval <= 0 {
+		return nil, fmt.Errorf("missing vehicles check interval must be positive: %s", cfg.Interval)
+	}
+	return service.NewMissingVehicleDetector(schedule, overrides, state, service.MissingRules{
+		LateStart:    cfg.LateStart,
+		NotReporting: cfg.NotReporting,
+		Silent:       cfg.Silent,
+		Interval:     cfg.Interval,
+	}), nil
+}
+
+// NewClockSkewEstimator возвращает оценку расхождения часов трекеров или nil, если она отключена.
+func NewClockSkewEstimator(cfg config.ClockSkew) *service.ClockSkewEstimator {
+	if !cfg.Enabled {
+		return nil
+	}
+	return service.NewClockSkewEstimator(service.ClockSkewRules{
+		Window:     cfg.Window,
+		MinSamples: cfg.MinSamples,
+		Threshold:  cfg.Threshold,
+		Auto:       cfg.Auto,
+		Offsets:    cfg.Offsets,
+	})
+}
+
+// NewTrackerMerger возвращает объединение трекеров транспорта или nil, если оно отключено.
+func NewTrackerMerger(cfg config.Trackers) *service.TrackerMerger {
+	if !cfg.Enabled {
+		return nil
+	}
+	return service.NewTrackerMerger(cfg.Silence)
+}
+
+// NewUIDNamespaces возвращает пространства имен UID по приемникам или nil, если они отключены.
+func NewUIDNamespaces(cfg config.UIDNamespace) (*service.UIDNamespaces, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	receivers := make(map[model.Receiver]service.UIDTransform, 3)
+	for receiver, c := range map[model.Receiver]config.UIDTransform{
+		model.ReceiverWialonIPS: cfg.WialonIPS,
+		model.ReceiverEGTS:      cfg.EGTS,
+		model.ReceiverGRPC:      cfg.GRPC,
+	} {
+		if strings.Contains(c.Prefix, ":") {
+			return nil, fmt.Errorf("%s uid prefix %q must not contain ':'", receiver, c.Prefix)
+		}
+		transform := service.UIDTransform{
+			Prefix:     c.Prefix,
+			Replace:    c.Replace,
+			StripZeros: c.StripZeros,
+		}
+		if c.Pattern != "" {
+			pattern, err := regexp.Compile(c.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("compile %s uid pattern: %w", receiver, err)
+			}
+			transform.Pattern = pattern
+		}
+		receivers[receiver] = transform
+	}
+	return service.NewUIDNamespaces(receivers, cfg.Legacy), nil
+}
+
+// NewScheduleResolver возвращает сервис определения расписания с допусками и закрепленными маршрутами
+// или nil, если они не используются.
+func NewScheduleResolver(
+	cfg config.Schedule,
+	schedule *repository.Schedule,
+	defaults *repository.DefaultRoute,
+) *service.ScheduleResolver {
+	if cfg.PreStart <= 0 && cfg.PostEnd <= 0 && cfg.LastAssignment <= 0 && defaults == nil {
+		return nil
+	}
+	return service.NewScheduleResolver(schedule, defaults, service.ScheduleRules{
+		PreStart:       cfg.PreStart,
+		PostEnd:        cfg.PostEnd,
+		LastAssignment: cfg.LastAssignment,
+	})
+}
+
+// NewDispatcher возвращает распределитель GPS-данных по очередям обработки.
+func NewDispatcher(cfg config.Dispatcher, busTracking *service.BusTracking) (*service.Dispatcher, error) {
+	overflow, err := service.ParseOverflow(cfg.Overflow)
+	if err != nil {
+		return nil, err
+	}
+	return service.NewDispatcher(busTracking.ProcessGPSData, service.DispatcherRules{
+		Workers:   cfg.Workers,
+		QueueSize: cfg.QueueSize,
+		Overflow:  overflow,
+	}), nil
+}
+
+// NewJournal возвращает журнал с ротацией файла или nil, если имя файла не задано.
+func NewJournal(file string) io.Writer {
+	if file == "" {
+		return nil
+	}
+	return &lumberjack.Logger{
+		Filename: file,
+		MaxSize:  10,
+		MaxAge:   30,
+		Compress: true,
+	}
+}
+
 func SetupLogger(cfg config.Logger) {
 	handlers := []slog.Handler{
 		slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: cfg.Level}),
@@ -4,6 +4,7 @@ go 1.24
 
 require (
 	github.com/caarlos0/env/v11 v11.3.1
+	github.com/coder/websocket v1.8.13
 	github.com/imkira/go-observer/v2 v2.0.0-20230629064422-8e0b61f11f1b
 	github.com/joho/godotenv v1.5.1
 	github.com/kuznetsovin/egts-protocol v0.0.0-20240521125600-5bd205013805
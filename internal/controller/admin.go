@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/bars43ru/bus2map/api/bustracking"
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+	"github.com/bars43ru/bus2map/internal/service"
+	"github.com/bars43ru/bus2map/pkg/xslog"
+)
+
+// Admin gRPC сервис административных операций диспетчера.
+type Admin struct {
+	pb.UnsafeBusTrackingAdminServiceServer
+	service *service.BusTracking
+}
+
+func NewAdminService(service *service.BusTracking) *Admin {
+	return &Admin{
+		service: service,
+	}
+}
+
+// operatorKey ключ контекста с диспетчером, определенным по токену
+type operatorKey struct{}
+
+// AdminAuth возвращает перехватчик, допускающий к административным операциям только запросы с токеном
+// диспетчера в метаданных authorization в формате "Bearer <токен>". operators - токены диспетчеров
+// по имени диспетчера; имя записывается в журнал аудита.
+func AdminAuth(operators map[string]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		for _, value := range md.Get("authorization") {
+			token, ok := strings.CutPrefix(value, "Bearer ")
+			if !ok || token == "" {
+				continue
+			}
+			for operator, operatorToken := range operators {
+				if subtle.ConstantTimeCompare([]byte(token), []byte(operatorToken)) == 1 {
+					return handler(context.WithValue(ctx, operatorKey{}, operator), req)
+				}
+			}
+		}
+		return nil, status.Error(codes.Unauthenticated, "valid operator token is required")
+	}
+}
+
+// operator возвращает диспетчера, определенного перехватчиком AdminAuth.
+func operator(ctx context.Context) (string, error) {
+	operator, _ := ctx.Value(operatorKey{}).(string)
+	if operator == "" {
+		return "", status.Error(codes.Unauthenticated, "operator is not authenticated")
+	}
+	return operator, nil
+}
+
+func (s *Admin) CreateOverride(ctx context.Context, req *pb.CreateOverrideRequest) (*pb.ScheduleOverride, error) {
+	operator, err := operator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetStateNumber() == "" || req.GetRouteNumber() == "" {
+		return nil, status.Error(codes.InvalidArgument, "state_number and route_number are required")
+	}
+	if req.GetTo() == nil {
+		return nil, status.Error(codes.InvalidArgument, "to is required")
+	}
+	now := time.Now()
+	from := now
+	if req.GetFrom() != nil {
+		from = req.GetFrom().AsTime()
+	}
+	to := req.GetTo().AsTime()
+	if !to.After(from) || !to.After(now) {
+		return nil, status.Error(codes.InvalidArgument, "to must be after from and in the future")
+	}
+	override, err := s.service.CreateOverride(ctx, model.ScheduleOverride{
+		Schedule: model.Schedule{
+			Number:      model.RouteNumber(req.GetRouteNumber()),
+			StateNumber: model.StateNumber(req.GetStateNumber()),
+			From:        from,
+			To:          to,
+		},
+		Reason:    req.GetReason(),
+		CreatedBy: operator,
+	})
+	if err != nil {
+		return nil, s.error(ctx, "create override", err, "route not found", codes.InvalidArgument)
+	}
+	return s.overrideToPbOverride(override), nil
+}
+
+func (s *Admin) ListOverrides(ctx context.Context, req *pb.ListOverridesRequest) (*pb.ListOverridesResponse, error) {
+	items, err := s.service.Overrides()
+	if err != nil {
+		return nil, s.error(ctx, "list overrides", err, "", codes.NotFound)
+	}
+	stateNumber := model.StateNumber(req.GetStateNumber())
+	resp := &pb.ListOverridesResponse{
+		Items: make([]*pb.ScheduleOverride, 0, len(items)),
+	}
+	for _, item := range items {
+		if stateNumber != "" && item.Schedule.StateNumber != stateNumber {
+			continue
+		}
+		resp.Items = append(resp.Items, s.overrideToPbOverride(item))
+	}
+	return resp, nil
+}
+
+func (s *Admin) DeleteOverride(ctx context.Context, req *pb.DeleteOverrideRequest) (*pb.ScheduleOverride, error) {
+	operator, err := operator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	override, err := s.service.DeleteOverride(ctx, req.GetId(), operator)
+	if err != nil {
+		return nil, s.error(ctx, "delete override", err, "override not found", codes.NotFound)
+	}
+	return s.overrideToPbOverride(override), nil
+}
+
+// error преобразует ошибку сервиса в статус gRPC; repository.ErrNotFound возвращается с кодом notFoundCode.
+func (s *Admin) error(ctx context.Context, msg string, err error, notFound string, notFoundCode codes.Code) error {
+	switch {
+	case errors.Is(err, service.ErrOverridesDisabled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, repository.ErrNotFound):
+		return status.Error(notFoundCode, notFound)
+	}
+	slog.ErrorContext(ctx, msg, xslog.Error(err))
+	return status.Error(codes.Internal, err.Error())
+}
+
+func (s *Admin) overrideToPbOverride(override model.ScheduleOverride) *pb.ScheduleOverride {
+	return &pb.ScheduleOverride{
+		Id: override.ID,
+		Schedule: &pb.Schedule{
+			Number:      override.Schedule.Number.String(),
+			StateNumber: override.Schedule.StateNumber.String(),
+			From:        timestamppb.New(override.Schedule.From),
+			To:          timestamppb.New(override.Schedule.To),
+		},
+		Reason:    override.Reason,
+		CreatedBy: override.CreatedBy,
+		CreatedAt: timestamppb.New(override.CreatedAt),
+	}
+}
@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAdminAuth(t *testing.T) {
+	auth := AdminAuth(map[string]string{"ivanov": "secret1", "petrov": "secret2"})
+	call := func(values ...string) (any, error) {
+		ctx := context.Background()
+		if len(values) != 0 {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(values...))
+		}
+		return auth(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+			return ctx, nil
+		})
+	}
+
+	for _, values := range [][]string{
+		nil,
+		{"authorization", "secret2"},
+		{"authorization", "Bearer "},
+		{"authorization", "Bearer unknown"},
+	} {
+		_, err := call(values...)
+		require.Equal(t, codes.Unauthenticated, status.Code(err), "%v", values)
+	}
+
+	ctx, err := call("authorization", "Bearer secret2")
+	require.NoError(t, err)
+	name, err := operator(ctx.(context.Context))
+	require.NoError(t, err)
+	require.Equal(t, "petrov", name, "operator is derived from the token")
+}
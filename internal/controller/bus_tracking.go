@@ -1,15 +1,25 @@
 package controller
 
 import (
+	"context"
+	"errors"
 	"io"
 	"log/slog"
+	"slices"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/bars43ru/bus2map/api/bustracking"
 	"github.com/bars43ru/bus2map/internal/model"
 	"github.com/bars43ru/bus2map/internal/model/transport_type"
+	"github.com/bars43ru/bus2map/internal/receiver"
+	"github.com/bars43ru/bus2map/internal/repository"
 	"github.com/bars43ru/bus2map/internal/service"
 	"github.com/bars43ru/bus2map/pkg/xslog"
 )
@@ -27,16 +37,85 @@ var (
 		pb.Transport_TRAMWAY:    transport_type.TypeTRAMWAY,
 		pb.Transport_TROLLEYBUS: transport_type.TypeTROLLEYBUS,
 	}
+	_DiagnosticKindToPbDiagnosticKind = map[model.DiagnosticKind]pb.Diagnostic_Kind{
+		model.DiagnosticUnknownUID: pb.Diagnostic_UNKNOWN_UID,
+		model.DiagnosticNoSchedule: pb.Diagnostic_NO_SCHEDULE,
+		model.DiagnosticNoRoute:    pb.Diagnostic_NO_ROUTE,
+	}
+	_GeofenceTypeToPbZoneType = map[model.GeofenceType]pb.Zone_Type{
+		model.GeofenceServiceArea: pb.Zone_SERVICE_AREA,
+		model.GeofenceDepot:       pb.Zone_DEPOT,
+		model.GeofenceNoPublish:   pb.Zone_NO_PUBLISH,
+	}
+	_AlertKindToPbAlertKind = map[model.AlertKind]pb.Alert_Kind{
+		model.AlertOffRoute: pb.Alert_OFF_ROUTE,
+		model.AlertBunching: pb.Alert_BUNCHING,
+		model.AlertGap:      pb.Alert_GAP,
+
+		model.AlertLateStart:    pb.Alert_LATE_START,
+		model.AlertNotReporting: pb.Alert_NOT_REPORTING,
+		model.AlertWentSilent:   pb.Alert_WENT_SILENT,
+	}
+	_PbAlertKindToAlertKind = map[pb.Alert_Kind]model.AlertKind{
+		pb.Alert_OFF_ROUTE: model.AlertOffRoute,
+		pb.Alert_BUNCHING:  model.AlertBunching,
+		pb.Alert_GAP:       model.AlertGap,
+
+		pb.Alert_LATE_START:    model.AlertLateStart,
+		pb.Alert_NOT_REPORTING: model.AlertNotReporting,
+		pb.Alert_WENT_SILENT:   model.AlertWentSilent,
+	}
+	_AlertStateToPbAlertState = map[model.AlertState]pb.Alert_State{
+		model.AlertRaised:  pb.Alert_RAISED,
+		model.AlertCleared: pb.Alert_CLEARED,
+	}
+	_StopEventKindToPbStopEventKind = map[model.StopEventKind]pb.StopEvent_Kind{
+		model.StopArrival:   pb.StopEvent_ARRIVAL,
+		model.StopDeparture: pb.StopEvent_DEPARTURE,
+	}
+	_ScheduleMatchToPbScheduleMatch = map[model.ScheduleMatch]pb.BusTrackingInfo_ScheduleMatch{
+		model.ScheduleExact:          pb.BusTrackingInfo_EXACT,
+		model.SchedulePreStart:       pb.BusTrackingInfo_PRE_START,
+		model.SchedulePostEnd:        pb.BusTrackingInfo_POST_END,
+		model.ScheduleLastAssignment: pb.BusTrackingInfo_LAST_ASSIGNMENT,
+		model.ScheduleDefaultRoute:   pb.BusTrackingInfo_DEFAULT_ROUTE,
+		model.ScheduleOverridden:     pb.BusTrackingInfo_OVERRIDE,
+	}
+	_ClockCorrectionToPbCorrection = map[model.ClockCorrection]pb.ClockSkew_Correction{
+		model.ClockCorrectionNone:       pb.ClockSkew_NONE,
+		model.ClockCorrectionAuto:       pb.ClockSkew_AUTO,
+		model.ClockCorrectionConfigured: pb.ClockSkew_CONFIGURED,
+	}
+	_AdherenceStatusToPbAdherenceStatus = map[model.AdherenceStatus]pb.Adherence_Status{
+		model.AdherenceOnTime: pb.Adherence_ON_TIME,
+		model.AdherenceEarly:  pb.Adherence_EARLY,
+		model.AdherenceLate:   pb.Adherence_LATE,
+	}
+	_PbDiagnosticKindToDiagnosticKind = map[pb.Diagnostic_Kind]model.DiagnosticKind{
+		pb.Diagnostic_UNKNOWN_UID: model.DiagnosticUnknownUID,
+		pb.Diagnostic_NO_SCHEDULE: model.DiagnosticNoSchedule,
+		pb.Diagnostic_NO_ROUTE:    model.DiagnosticNoRoute,
+	}
 )
 
 type BusTracking struct {
 	pb.UnsafeBusTrackingServiceServer
-	service *service.BusTracking
+	service      *service.BusTracking
+	gpsLocator   receiver.GPSLocator
+	activeWindow time.Duration
 }
 
-func NewBusTrackingService(service *service.BusTracking) *BusTracking {
+// NewBusTrackingService создает gRPC сервис; принятые GPS-данные передаются в gpsLocator,
+// данные транспортного средства старше activeWindow считаются устаревшими.
+func NewBusTrackingService(
+	service *service.BusTracking,
+	gpsLocator receiver.GPSLocator,
+	activeWindow time.Duration,
+) *BusTracking {
 	return &BusTracking{
-		service: service,
+		service:      service,
+		gpsLocator:   gpsLocator,
+		activeWindow: activeWindow,
 	}
 }
 
@@ -55,15 +134,17 @@ func (s *BusTracking) StreamGPSData(stream grpc.ClientStreamingServer[pb.GPSData
 			return err
 		}
 		gpsData := model.GPS{
-			UID:       pbGPSData.GetUid(),
-			Time:      pbGPSData.Time.AsTime(),
-			Latitude:  pbGPSData.Latitude,
-			Longitude: pbGPSData.Longitude,
-			Speed:     pbGPSData.Speed,
-			Course:    pbGPSData.Course,
+			UID:        pbGPSData.GetUid(),
+			Time:       pbGPSData.Time.AsTime(),
+			Latitude:   pbGPSData.Latitude,
+			Longitude:  pbGPSData.Longitude,
+			Speed:      pbGPSData.Speed,
+			Course:     pbGPSData.Course,
+			Receiver:   model.ReceiverGRPC,
+			ReceivedAt: time.Now(),
 		}
 		slog.InfoContext(ctx, "GPS data transmitter received data")
-		s.service.ProcessGPSData(ctx, gpsData)
+		s.gpsLocator.ProcessGPSData(ctx, gpsData)
 	}
 }
 
@@ -81,12 +162,7 @@ func (s *BusTracking) StreamBusTrackingInfo(
 			return nil
 		case <-watcher.Changes():
 			busTrackingInfo := watcher.Next()
-			pbBusTrackingInfo := &pb.BusTrackingInfo{
-				GpsData:   s.gpsDataToPbGPSData(busTrackingInfo.Location),
-				Route:     s.routeToPbRoute(busTrackingInfo.Route),
-				Transport: s.transportToPbTransport(busTrackingInfo.Transport),
-				Schedule:  s.scheduleToPbSchedule(busTrackingInfo.Schedule),
-			}
+			pbBusTrackingInfo := s.busTrackingInfoToPbBusTrackingInfo(*busTrackingInfo)
 			slog.InfoContext(ctx, "processed GPS data listener send data")
 			err := stream.Send(pbBusTrackingInfo)
 			if err != nil {
@@ -97,6 +173,438 @@ func (s *BusTracking) StreamBusTrackingInfo(
 	}
 }
 
+func (s *BusTracking) StreamAlerts(
+	req *pb.StreamAlertsRequest,
+	stream grpc.ServerStreamingServer[pb.Alert],
+) error {
+	ctx := stream.Context()
+	slog.InfoContext(ctx, "alerts listener connected")
+	kinds := make([]model.AlertKind, 0, len(req.GetKinds()))
+	for _, kind := range req.GetKinds() {
+		kinds = append(kinds, _PbAlertKindToAlertKind[kind])
+	}
+	match := func(alert model.Alert) bool {
+		if len(kinds) != 0 && !slices.Contains(kinds, alert.Kind) {
+			return false
+		}
+		return req.GetRouteNumber() == "" || req.GetRouteNumber() == alert.RouteNumber.String()
+	}
+
+	// Подписка оформляется до получения активных событий, чтобы не пропустить изменения между ними.
+	watcher := s.service.SubscribeAlerts()
+	if req.GetIncludeHistory() {
+		for _, alert := range s.service.AlertHistory() {
+			if !match(alert) {
+				continue
+			}
+			if err := stream.Send(s.alertToPbAlert(alert)); err != nil {
+				slog.ErrorContext(ctx, "sending alert history to subscribe client", xslog.Error(err))
+				return err
+			}
+		}
+	}
+	if req.GetIncludeActive() {
+		for _, alert := range s.service.ActiveAlerts() {
+			if !match(alert) {
+				continue
+			}
+			if err := stream.Send(s.alertToPbAlert(alert)); err != nil {
+				slog.ErrorContext(ctx, "sending active alert to subscribe client", xslog.Error(err))
+				return err
+			}
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "alerts listener closed")
+			return nil
+		case <-watcher.Changes():
+			alert := watcher.Next()
+			if alert == nil || !match(*alert) {
+				continue
+			}
+			if err := stream.Send(s.alertToPbAlert(*alert)); err != nil {
+				slog.ErrorContext(ctx, "sending alert to subscribe client", xslog.Error(err))
+				return err
+			}
+		}
+	}
+}
+
+func (s *BusTracking) ListStopPredictions(
+	ctx context.Context,
+	req *pb.ListStopPredictionsRequest,
+) (*pb.ListStopPredictionsResponse, error) {
+	resp := &pb.ListStopPredictionsResponse{}
+	for _, trip := range s.service.Trips(time.Now().Add(-s.activeWindow)) {
+		if req.GetRouteNumber() != "" && req.GetRouteNumber() != trip.RouteNumber.String() {
+			continue
+		}
+		if req.GetStateNumber() != "" && req.GetStateNumber() != trip.StateNumber.String() {
+			continue
+		}
+		for i, prediction := range trip.Predictions {
+			if req.GetStopId() != "" && req.GetStopId() != prediction.Stop.ID.String() {
+				continue
+			}
+			resp.Items = append(resp.Items, &pb.StopPrediction{
+				StateNumber: trip.StateNumber.String(),
+				RouteNumber: trip.RouteNumber.String(),
+				Stop:        s.routeStopToPbStop(prediction.Stop),
+				Arrival:     timestamppb.New(prediction.Arrival),
+				Departure:   timestamppb.New(prediction.Departure),
+				AtStop:      i == 0 && trip.AtStop,
+			})
+		}
+	}
+	slices.SortFunc(resp.Items, func(a, b *pb.StopPrediction) int {
+		return a.GetArrival().AsTime().Compare(b.GetArrival().AsTime())
+	})
+	return resp, nil
+}
+
+func (s *BusTracking) StreamStopEvents(
+	req *pb.StreamStopEventsRequest,
+	stream grpc.ServerStreamingServer[pb.StopEvent],
+) error {
+	ctx := stream.Context()
+	slog.InfoContext(ctx, "stop events listener connected")
+	watcher := s.service.SubscribeStopEvents()
+	for {
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "stop events listener closed")
+			return nil
+		case <-watcher.Changes():
+			event := watcher.Next()
+			if event == nil {
+				continue
+			}
+			if req.GetRouteNumber() != "" && req.GetRouteNumber() != event.RouteNumber.String() {
+				continue
+			}
+			if req.GetStopId() != "" && req.GetStopId() != event.Stop.ID.String() {
+				continue
+			}
+			err := stream.Send(&pb.StopEvent{
+				Kind:        _StopEventKindToPbStopEventKind[event.Kind],
+				StateNumber: event.StateNumber.String(),
+				Uid:         event.UID,
+				RouteNumber: event.RouteNumber.String(),
+				Stop:        s.routeStopToPbStop(event.Stop),
+				Time:        timestamppb.New(event.Time),
+			})
+			if err != nil {
+				slog.ErrorContext(ctx, "sending stop event to subscribe client", xslog.Error(err))
+				return err
+			}
+		}
+	}
+}
+
+func (s *BusTracking) ListHeadways(
+	ctx context.Context,
+	req *pb.ListHeadwaysRequest,
+) (*pb.ListHeadwaysResponse, error) {
+	items := s.service.Headways(model.RouteNumber(req.GetRouteNumber()))
+	resp := &pb.ListHeadwaysResponse{
+		Items: make([]*pb.RouteHeadway, 0, len(items)),
+	}
+	for _, item := range items {
+		route := &pb.RouteHeadway{
+			RouteNumber:    item.RouteNumber.String(),
+			Planned:        durationpb.New(item.Planned),
+			Mean:           durationpb.New(item.Mean),
+			Min:            durationpb.New(item.Min),
+			Max:            durationpb.New(item.Max),
+			BunchingEvents: item.BunchingEvents,
+			GapEvents:      item.GapEvents,
+		}
+		for _, v := range item.Vehicles {
+			vehicle := &pb.VehicleHeadway{
+				StateNumber:   v.StateNumber.String(),
+				Leader:        v.Leader.String(),
+				DistanceAlong: v.DistanceAlong,
+			}
+			if v.Known {
+				vehicle.Headway = durationpb.New(v.Headway)
+			}
+			route.Vehicles = append(route.Vehicles, vehicle)
+		}
+		resp.Items = append(resp.Items, route)
+	}
+	return resp, nil
+}
+
+func (s *BusTracking) ListMissingVehicles(
+	ctx context.Context,
+	req *pb.ListMissingVehiclesRequest,
+) (*pb.ListMissingVehiclesResponse, error) {
+	kinds := make([]model.AlertKind, 0, len(req.GetKinds()))
+	for _, kind := range req.GetKinds() {
+		kinds = append(kinds, _PbAlertKindToAlertKind[kind])
+	}
+	route := model.RouteNumber(req.GetRouteNumber())
+	items := s.service.MissingVehicles()
+	resp := &pb.ListMissingVehiclesResponse{
+		Items: make([]*pb.MissingVehicle, 0, len(items)),
+	}
+	for _, item := range items {
+		if len(kinds) > 0 && !slices.Contains(kinds, item.Kind) {
+			continue
+		}
+		if route != "" && item.Schedule.Number != route {
+			continue
+		}
+		missing := &pb.MissingVehicle{
+			StateNumber:  item.StateNumber.String(),
+			RouteNumber:  item.Schedule.Number.String(),
+			ScheduleFrom: timestamppb.New(item.Schedule.From),
+			ScheduleTo:   timestamppb.New(item.Schedule.To),
+			Kind:         _AlertKindToPbAlertKind[item.Kind],
+			Since:        timestamppb.New(item.Since),
+		}
+		if !item.LastSeen.IsZero() {
+			missing.LastSeen = timestamppb.New(item.LastSeen)
+		}
+		resp.Items = append(resp.Items, missing)
+	}
+	return resp, nil
+}
+
+func (s *BusTracking) ListClockSkew(
+	ctx context.Context,
+	req *pb.ListClockSkewRequest,
+) (*pb.ListClockSkewResponse, error) {
+	items := s.service.ClockSkew(req.GetUid())
+	resp := &pb.ListClockSkewResponse{
+		Items: make([]*pb.ClockSkew, 0, len(items)),
+	}
+	for _, item := range items {
+		resp.Items = append(resp.Items, &pb.ClockSkew{
+			Uid:        item.UID,
+			Samples:    int32(item.Samples),
+			Skew:       durationpb.New(item.Skew),
+			MinSkew:    durationpb.New(item.MinSkew),
+			MaxSkew:    durationpb.New(item.MaxSkew),
+			Offset:     durationpb.New(item.Offset),
+			Correction: _ClockCorrectionToPbCorrection[item.Correction],
+			UpdatedAt:  timestamppb.New(item.UpdatedAt),
+		})
+	}
+	return resp, nil
+}
+
+func (s *BusTracking) ListTransportHistory(
+	ctx context.Context,
+	req *pb.ListTransportHistoryRequest,
+) (*pb.ListTransportHistoryResponse, error) {
+	items := s.service.TransportHistory(req.GetUid(), model.StateNumber(req.GetStateNumber()))
+	resp := &pb.ListTransportHistoryResponse{
+		Items: make([]*pb.TransportBinding, 0, len(items)),
+	}
+	for _, item := range items {
+		binding := &pb.TransportBinding{
+			Transport: s.transportToPbTransport(item),
+			Priority:  int32(item.Priority),
+		}
+		if !item.ValidFrom.IsZero() {
+			binding.ValidFrom = timestamppb.New(item.ValidFrom)
+		}
+		if !item.ValidTo.IsZero() {
+			binding.ValidTo = timestamppb.New(item.ValidTo)
+		}
+		resp.Items = append(resp.Items, binding)
+	}
+	return resp, nil
+}
+
+func (s *BusTracking) ListDiagnostics(
+	ctx context.Context,
+	req *pb.ListDiagnosticsRequest,
+) (*pb.ListDiagnosticsResponse, error) {
+	kinds := make([]model.DiagnosticKind, 0, len(req.GetKinds()))
+	for _, kind := range req.GetKinds() {
+		kinds = append(kinds, _PbDiagnosticKindToDiagnosticKind[kind])
+	}
+	items := s.service.Diagnostics(kinds...)
+	resp := &pb.ListDiagnosticsResponse{
+		Items: make([]*pb.Diagnostic, 0, len(items)),
+	}
+	for _, item := range items {
+		resp.Items = append(resp.Items, &pb.Diagnostic{
+			Kind:        _DiagnosticKindToPbDiagnosticKind[item.Kind],
+			Uid:         item.UID,
+			StateNumber: item.StateNumber.String(),
+			RouteNumber: item.RouteNumber.String(),
+			FirstSeen:   timestamppb.New(item.FirstSeen),
+			LastSeen:    timestamppb.New(item.LastSeen),
+			Count:       item.Count,
+			LastGpsData: s.gpsDataToPbGPSData(item.LastLocation),
+		})
+	}
+	return resp, nil
+}
+
+func (s *BusTracking) GetVehicle(ctx context.Context, req *pb.GetVehicleRequest) (*pb.VehicleState, error) {
+	if req.GetStateNumber() == "" && req.GetUid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "state_number or uid is required")
+	}
+	v, err := s.service.Vehicle(model.StateNumber(req.GetStateNumber()), req.GetUid())
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "vehicle not found")
+		}
+		slog.ErrorContext(ctx, "get vehicle", xslog.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return s.vehicleStateToPbVehicleState(v, time.Now()), nil
+}
+
+func (s *BusTracking) ListVehicles(_ context.Context, req *pb.ListVehiclesRequest) (*pb.ListVehiclesResponse, error) {
+	types := make([]transport_type.Type, 0, len(req.GetTypes()))
+	for _, t := range req.GetTypes() {
+		types = append(types, _PbTransportTypeToTransportType[t])
+	}
+	var activeSince time.Time
+	if req.GetActiveSince() != nil {
+		activeSince = req.GetActiveSince().AsTime()
+	}
+
+	now := time.Now()
+	resp := &pb.ListVehiclesResponse{}
+	for _, v := range s.service.Vehicles() {
+		if req.GetRouteNumber() != "" && v.Info.Route.Number.String() != req.GetRouteNumber() {
+			continue
+		}
+		if len(types) != 0 && !slices.Contains(types, v.Info.Transport.Type) {
+			continue
+		}
+		if v.UpdatedAt.Before(activeSince) {
+			continue
+		}
+		resp.Items = append(resp.Items, s.vehicleStateToPbVehicleState(v, now))
+	}
+	slices.SortFunc(resp.Items, func(a, b *pb.VehicleState) int {
+		return strings.Compare(a.GetInfo().GetTransport().GetStateNumber(), b.GetInfo().GetTransport().GetStateNumber())
+	})
+	return resp, nil
+}
+
+func (s *BusTracking) ListRoutesActivity(
+	_ context.Context,
+	req *pb.ListRoutesActivityRequest,
+) (*pb.ListRoutesActivityResponse, error) {
+	activeSince := time.Now().Add(-s.activeWindow)
+	if req.GetActiveSince() != nil {
+		activeSince = req.GetActiveSince().AsTime()
+	}
+	items := s.service.RoutesActivity(activeSince)
+	resp := &pb.ListRoutesActivityResponse{
+		Items: make([]*pb.RouteActivity, 0, len(items)),
+	}
+	for _, item := range items {
+		stateNumbers := make([]string, 0, len(item.StateNumbers))
+		for _, stateNumber := range item.StateNumbers {
+			stateNumbers = append(stateNumbers, stateNumber.String())
+		}
+		resp.Items = append(resp.Items, &pb.RouteActivity{
+			Route:        s.routeToPbRoute(item.Route),
+			StateNumbers: stateNumbers,
+			LastUpdate:   timestamppb.New(item.LastUpdate),
+		})
+	}
+	return resp, nil
+}
+
+func (s *BusTracking) vehicleStateToPbVehicleState(v model.VehicleState, now time.Time) *pb.VehicleState {
+	return &pb.VehicleState{
+		Info:      s.busTrackingInfoToPbBusTrackingInfo(v.Info),
+		UpdatedAt: timestamppb.New(v.UpdatedAt),
+		Age:       durationpb.New(now.Sub(v.Info.Location.Time)),
+		Stale:     now.Sub(v.UpdatedAt) > s.activeWindow,
+	}
+}
+
+func (s *BusTracking) busTrackingInfoToPbBusTrackingInfo(info model.BusTrackingInfo) *pb.BusTrackingInfo {
+	return &pb.BusTrackingInfo{
+		GpsData:       s.gpsDataToPbGPSData(info.Location),
+		Route:         s.routeToPbRoute(info.Route),
+		Transport:     s.transportToPbTransport(info.Transport),
+		Schedule:      s.scheduleToPbSchedule(info.Schedule),
+		Zones:         s.zonesToPbZones(info.Zones),
+		RouteMatch:    s.routeMatchToPbRouteMatch(info.Match),
+		Adherence:     s.adherenceToPbAdherence(info.Adherence),
+		ScheduleMatch: _ScheduleMatchToPbScheduleMatch[info.ScheduleMatch],
+	}
+}
+
+func (s *BusTracking) adherenceToPbAdherence(adherence model.Adherence) *pb.Adherence {
+	if !adherence.Known {
+		return nil
+	}
+	return &pb.Adherence{
+		TripId:    adherence.TripID,
+		Deviation: int64(adherence.Deviation.Seconds()),
+		Status:    _AdherenceStatusToPbAdherenceStatus[adherence.Status],
+	}
+}
+
+func (s *BusTracking) routeMatchToPbRouteMatch(match model.RouteMatch) *pb.RouteMatch {
+	if !match.HasShape {
+		return nil
+	}
+	return &pb.RouteMatch{
+		Matched:       match.Matched,
+		Latitude:      match.Position.Latitude,
+		Longitude:     match.Position.Longitude,
+		DistanceAlong: match.DistanceAlong,
+		Offset:        match.Offset,
+	}
+}
+
+func (s *BusTracking) alertToPbAlert(alert model.Alert) *pb.Alert {
+	result := &pb.Alert{
+		Kind:        _AlertKindToPbAlertKind[alert.Kind],
+		State:       _AlertStateToPbAlertState[alert.State],
+		StateNumber: alert.StateNumber.String(),
+		Uid:         alert.UID,
+		RouteNumber: alert.RouteNumber.String(),
+		Since:       timestamppb.New(alert.Since),
+		Time:        timestamppb.New(alert.Time),
+		GpsData:     s.gpsDataToPbGPSData(alert.Location),
+		Offset:      alert.Offset,
+		Leader:      alert.Leader.String(),
+	}
+	if alert.Kind == model.AlertBunching || alert.Kind == model.AlertGap {
+		result.Headway = durationpb.New(alert.Headway)
+	}
+	return result
+}
+
+func (s *BusTracking) routeStopToPbStop(stop model.RouteStop) *pb.Stop {
+	return &pb.Stop{
+		Id:        stop.ID.String(),
+		Name:      stop.Name,
+		Latitude:  stop.Location.Latitude,
+		Longitude: stop.Location.Longitude,
+		Sequence:  stop.Sequence,
+	}
+}
+
+func (s *BusTracking) zonesToPbZones(zones []model.Zone) []*pb.Zone {
+	result := make([]*pb.Zone, 0, len(zones))
+	for _, zone := range zones {
+		result = append(result, &pb.Zone{
+			Id:   zone.ID,
+			Name: zone.Name,
+			Type: _GeofenceTypeToPbZoneType[zone.Type],
+		})
+	}
+	return result
+}
+
 func (s *BusTracking) gpsDataToPbGPSData(gps model.GPS) *pb.GPSData {
 	return &pb.GPSData{
 		Uid:       gps.UID,
@@ -105,6 +613,7 @@ func (s *BusTracking) gpsDataToPbGPSData(gps model.GPS) *pb.GPSData {
 		Speed:     gps.Speed,
 		Course:    gps.Course,
 		Time:      timestamppb.New(gps.Time),
+		Receiver:  gps.Receiver.String(),
 	}
 }
 
@@ -0,0 +1,131 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/bars43ru/bus2map/api/bustracking"
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/model/transport_type"
+	"github.com/bars43ru/bus2map/internal/repository"
+	"github.com/bars43ru/bus2map/internal/service"
+)
+
+func newTestBusTracking(t *testing.T, now time.Time) *BusTracking {
+	t.Helper()
+	state := repository.NewVehicleState()
+	update := func(stateNumber model.StateNumber, uid string, typ transport_type.Type, route model.RouteNumber, age time.Duration) {
+		state.Update(model.BusTrackingInfo{
+			Transport: model.Transport{StateNumber: stateNumber, Type: typ},
+			Route:     model.Route{Number: route},
+			Location:  model.GPS{UID: uid, Time: now.Add(-age)},
+		}, now.Add(-age))
+	}
+	update("A001AA", "1", transport_type.TypeBUS, "1", time.Minute)
+	update("B002BB", "2", transport_type.TypeTROLLEYBUS, "1", 2*time.Minute)
+	update("C003CC", "3", transport_type.TypeBUS, "2", 3*time.Minute)
+	update("D004DD", "4", transport_type.TypeBUS, "2", time.Hour)
+	return NewBusTrackingService(service.New(service.Options{State: state}), nil, 10*time.Minute)
+}
+
+func stateNumbers(items []*pb.VehicleState) []string {
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		result = append(result, item.GetInfo().GetTransport().GetStateNumber())
+	}
+	return result
+}
+
+func TestBusTracking_GetVehicle(t *testing.T) {
+	s := newTestBusTracking(t, time.Now())
+	ctx := context.Background()
+
+	v, err := s.GetVehicle(ctx, &pb.GetVehicleRequest{Key: &pb.GetVehicleRequest_StateNumber{StateNumber: "A001AA"}})
+	require.NoError(t, err)
+	require.Equal(t, "1", v.GetInfo().GetGpsData().GetUid())
+	require.False(t, v.GetStale())
+
+	v, err = s.GetVehicle(ctx, &pb.GetVehicleRequest{Key: &pb.GetVehicleRequest_Uid{Uid: "4"}})
+	require.NoError(t, err)
+	require.Equal(t, "D004DD", v.GetInfo().GetTransport().GetStateNumber())
+	require.True(t, v.GetStale(), "vehicle outside the active window is stale")
+
+	_, err = s.GetVehicle(ctx, &pb.GetVehicleRequest{Key: &pb.GetVehicleRequest_Uid{Uid: "5"}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.GetVehicle(ctx, &pb.GetVehicleRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestBusTracking_ListVehicles(t *testing.T) {
+	now := time.Now()
+	s := newTestBusTracking(t, now)
+	tests := []struct {
+		name string
+		req  *pb.ListVehiclesRequest
+		want []string
+	}{
+		{
+			name: "all vehicles including stale",
+			req:  &pb.ListVehiclesRequest{},
+			want: []string{"A001AA", "B002BB", "C003CC", "D004DD"},
+		},
+		{
+			name: "route",
+			req:  &pb.ListVehiclesRequest{RouteNumber: "1"},
+			want: []string{"A001AA", "B002BB"},
+		},
+		{
+			name: "types",
+			req:  &pb.ListVehiclesRequest{Types: []pb.Transport_Type{pb.Transport_TROLLEYBUS, pb.Transport_TRAMWAY}},
+			want: []string{"B002BB"},
+		},
+		{
+			name: "active since",
+			req:  &pb.ListVehiclesRequest{ActiveSince: timestamppb.New(now.Add(-150 * time.Second))},
+			want: []string{"A001AA", "B002BB"},
+		},
+		{
+			name: "all conditions",
+			req: &pb.ListVehiclesRequest{
+				RouteNumber: "2",
+				Types:       []pb.Transport_Type{pb.Transport_BUS},
+				ActiveSince: timestamppb.New(now.Add(-10 * time.Minute)),
+			},
+			want: []string{"C003CC"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.ListVehicles(context.Background(), tt.req)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, stateNumbers(resp.GetItems()))
+		})
+	}
+}
+
+func TestBusTracking_ListRoutesActivity(t *testing.T) {
+	now := time.Now()
+	s := newTestBusTracking(t, now)
+
+	resp, err := s.ListRoutesActivity(context.Background(), &pb.ListRoutesActivityRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetItems(), 2, "active window of the server is used by default")
+	require.Equal(t, "1", resp.GetItems()[0].GetRoute().GetNumber())
+	require.Equal(t, []string{"A001AA", "B002BB"}, resp.GetItems()[0].GetStateNumbers())
+	require.Equal(t, now.Add(-time.Minute).UnixNano(), resp.GetItems()[0].GetLastUpdate().AsTime().UnixNano())
+	require.Equal(t, "2", resp.GetItems()[1].GetRoute().GetNumber())
+	require.Equal(t, []string{"C003CC"}, resp.GetItems()[1].GetStateNumbers())
+
+	resp, err = s.ListRoutesActivity(context.Background(), &pb.ListRoutesActivityRequest{
+		ActiveSince: timestamppb.New(now.Add(-2 * time.Hour)),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetItems(), 2)
+	require.Equal(t, []string{"C003CC", "D004DD"}, resp.GetItems()[1].GetStateNumbers())
+}
@@ -0,0 +1,150 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bars43ru/bus2map/internal/service"
+	"github.com/bars43ru/bus2map/pkg/xslog"
+)
+
+// FleetSnapshot отдает последнее известное положение активных транспортных средств в формате GeoJSON.
+type FleetSnapshot struct {
+	service      *service.BusTracking
+	activeWindow time.Duration
+}
+
+func NewFleetSnapshot(service *service.BusTracking, activeWindow time.Duration) *FleetSnapshot {
+	return &FleetSnapshot{
+		service:      service,
+		activeWindow: activeWindow,
+	}
+}
+
+func (s *FleetSnapshot) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /vehicles.geojson", s.handleGeoJSON)
+}
+
+type featureCollection struct {
+	Type     string    `json:"type"`
+	Features []feature `json:"features"`
+}
+
+type feature struct {
+	Type       string            `json:"type"`
+	ID         string            `json:"id"`
+	Geometry   pointGeometry     `json:"geometry"`
+	Properties featureProperties `json:"properties"`
+}
+
+type pointGeometry struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+type featureProperties struct {
+	StateNumber   string    `json:"state_number"`
+	Type          string    `json:"type"`
+	Route         string    `json:"route"`
+	Speed         uint32    `json:"speed"`
+	Course        uint32    `json:"course"`
+	Time          time.Time `json:"time"`
+	Age           int64     `json:"age"` // секунд с момента получения координат
+	Zones         []string  `json:"zones,omitempty"`
+	Deviation     *int64    `json:"deviation,omitempty"` // отклонение от расписания в секундах, опоздание положительное
+	Adherence     string    `json:"adherence,omitempty"`
+	ScheduleMatch string    `json:"schedule_match,omitempty"` // способ определения расписания
+}
+
+func (s *FleetSnapshot) handleGeoJSON(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	filter, err := parseVehicleFilter(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	now := time.Now()
+	since := now.Add(-s.activeWindow)
+	collection := featureCollection{Type: "FeatureCollection", Features: []feature{}}
+	for _, v := range s.service.Vehicles() {
+		if v.UpdatedAt.Before(since) || !filter.Match(v.Info) {
+			continue
+		}
+		info := v.Info
+		collection.Features = append(collection.Features, feature{
+			Type: "Feature",
+			ID:   info.Transport.StateNumber.String(),
+			Geometry: pointGeometry{
+				Type:        "Point",
+				Coordinates: [2]float64{info.Location.Longitude, info.Location.Latitude},
+			},
+			Properties: featureProperties{
+				StateNumber:   info.Transport.StateNumber.String(),
+				Type:          info.Transport.Type.String(),
+				Route:         info.Route.Number.String(),
+				Speed:         info.Location.Speed,
+				Course:        info.Location.Course,
+				Time:          info.Location.Time,
+				Age:           int64(now.Sub(info.Location.Time).Seconds()),
+				Zones:         zoneIDs(info.Zones),
+				Deviation:     deviationSeconds(info.Adherence),
+				Adherence:     info.Adherence.Status.String(),
+				ScheduleMatch: info.ScheduleMatch.String(),
+			},
+		})
+	}
+	slices.SortFunc(collection.Features, func(a, b feature) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(collection); err != nil {
+		slog.ErrorContext(ctx, "encode geojson snapshot", xslog.Error(err))
+		http.Error(w, "encode snapshot", http.StatusInternalServerError)
+		return
+	}
+	etag := snapshotETag(filter, collection.Features)
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+	if etagMatch(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/geo+json")
+	if _, err := w.Write(body.Bytes()); err != nil {
+		slog.ErrorContext(ctx, "write geojson snapshot", xslog.Error(err))
+	}
+}
+
+// snapshotETag строит ETag по условиям отбора и времени координат транспортных средств снимка. Возраст данных
+// в ETag не учитывается, чтобы снимок без новых координат не считался изменившимся.
+func snapshotETag(filter vehicleFilter, features []feature) string {
+	h := fnv.New64a()
+	_, _ = fmt.Fprintf(h, "%q;%q;%v\n", filter.Routes, filter.Types, filter.BBox)
+	for _, f := range features {
+		_, _ = fmt.Fprintf(h, "%s;%d\n", f.ID, f.Properties.Time.UnixNano())
+	}
+	return fmt.Sprintf(`"%x"`, h.Sum64())
+}
+
+// etagMatch проверяет, совпадает ли etag с одним из значений заголовка If-None-Match.
+// Значения сравниваются без учета признака слабого ETag (W/), "*" совпадает с любым ETag.
+func etagMatch(header string, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, value := range strings.Split(header, ",") {
+		value = strings.TrimSpace(value)
+		if value == "*" || strings.TrimPrefix(value, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
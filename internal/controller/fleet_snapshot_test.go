@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+	"github.com/bars43ru/bus2map/internal/service"
+)
+
+func TestEtagMatch(t *testing.T) {
+	tests := []struct {
+		header string
+		match  bool
+	}{
+		{header: "", match: false},
+		{header: `"abc"`, match: true},
+		{header: `W/"abc"`, match: true},
+		{header: `"xyz", W/"abc"`, match: true},
+		{header: `*`, match: true},
+		{header: `"ab"`, match: false},
+		{header: `"abcd"`, match: false},
+		{header: `"xyz","abc1"`, match: false},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.match, etagMatch(tt.header, `"abc"`), tt.header)
+	}
+}
+
+func TestFleetSnapshot(t *testing.T) {
+	state := repository.NewVehicleState()
+	s := NewFleetSnapshot(service.New(service.Options{State: state}), time.Hour)
+	mux := http.NewServeMux()
+	s.Register(mux)
+	get := func(ifNoneMatch string, query ...string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/vehicles.geojson?"+strings.Join(query, "&"), nil)
+		if ifNoneMatch != "" {
+			r.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		return w
+	}
+	update := func(stateNumber model.StateNumber) {
+		state.Update(model.BusTrackingInfo{
+			Transport: model.Transport{StateNumber: stateNumber},
+			Route:     model.Route{Number: "1"},
+			Location:  model.GPS{UID: stateNumber.String(), Time: time.Now()},
+		}, time.Now())
+	}
+
+	update("A001AA")
+	resp := get("")
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Contains(t, resp.Body.String(), `"state_number":"A001AA"`)
+	etag := resp.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	require.Equal(t, http.StatusNotModified, get(etag).Code)
+	require.Equal(t, http.StatusNotModified, get(`"other", W/`+etag).Code)
+	require.Equal(t, http.StatusNotModified, get("*").Code)
+	resp = get(etag, "route=2")
+	require.Equal(t, http.StatusOK, resp.Code, "snapshot with other filters has another ETag")
+	require.NotEqual(t, etag, resp.Header().Get("ETag"))
+
+	update("B002BB")
+	resp = get(etag)
+	require.Equal(t, http.StatusOK, resp.Code, "changed snapshot is sent")
+	require.NotEqual(t, etag, resp.Header().Get("ETag"))
+}
@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bars43ru/bus2map/api/gtfsrt"
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/service"
+	"github.com/bars43ru/bus2map/pkg/xslog"
+)
+
+const gtfsRealtimeVersion = "2.0"
+
+// GTFSRealtime отдает прогнозы прибытия на остановки в формате GTFS Realtime TripUpdates.
+type GTFSRealtime struct {
+	service      *service.BusTracking
+	activeWindow time.Duration
+}
+
+func NewGTFSRealtime(service *service.BusTracking, activeWindow time.Duration) *GTFSRealtime {
+	return &GTFSRealtime{
+		service:      service,
+		activeWindow: activeWindow,
+	}
+}
+
+func (s *GTFSRealtime) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /gtfs-rt/trip-updates", s.handleTripUpdates)
+}
+
+// handleTripUpdates отдает ленту в бинарном формате protobuf, с параметром format=json - в JSON для отладки.
+func (s *GTFSRealtime) handleTripUpdates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	now := time.Now()
+	feed := &gtfsrt.FeedMessage{
+		Header: &gtfsrt.FeedHeader{
+			GtfsRealtimeVersion: proto.String(gtfsRealtimeVersion),
+			Incrementality:      gtfsrt.FeedHeader_FULL_DATASET.Enum(),
+			Timestamp:           proto.Uint64(uint64(now.Unix())),
+		},
+	}
+	for _, trip := range s.service.Trips(now.Add(-s.activeWindow)) {
+		if len(trip.Predictions) == 0 {
+			continue
+		}
+		feed.Entity = append(feed.Entity, &gtfsrt.FeedEntity{
+			Id:         proto.String(trip.StateNumber.String()),
+			TripUpdate: s.tripUpdate(trip),
+		})
+	}
+
+	var (
+		b   []byte
+		err error
+	)
+	if r.URL.Query().Get("format") == "json" {
+		w.Header().Set("Content-Type", "application/json")
+		b, err = protojson.Marshal(feed)
+	} else {
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		b, err = proto.Marshal(feed)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "marshal gtfs realtime feed", xslog.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if _, err := w.Write(b); err != nil {
+		slog.ErrorContext(ctx, "write gtfs realtime feed", xslog.Error(err))
+	}
+}
+
+func (s *GTFSRealtime) tripUpdate(trip model.TripProgress) *gtfsrt.TripUpdate {
+	// Статического GTFS с идентификаторами рейсов нет, рейс идентифицируется записью расписания.
+	start := trip.Schedule.From
+	update := &gtfsrt.TripUpdate{
+		Trip: &gtfsrt.TripDescriptor{
+			TripId:    proto.String(fmt.Sprintf("%s-%s-%s", trip.RouteNumber, trip.StateNumber, start.Format("20060102T1504"))),
+			RouteId:   proto.String(trip.RouteNumber.String()),
+			StartDate: proto.String(start.Format("20060102")),
+			StartTime: proto.String(start.Format(time.TimeOnly)),
+		},
+		Vehicle: &gtfsrt.VehicleDescriptor{
+			Id:           proto.String(trip.StateNumber.String()),
+			Label:        proto.String(trip.StateNumber.String()),
+			LicensePlate: proto.String(trip.StateNumber.String()),
+		},
+		Timestamp: proto.Uint64(uint64(trip.UpdatedAt.Unix())),
+	}
+	for _, prediction := range trip.Predictions {
+		update.StopTimeUpdate = append(update.StopTimeUpdate, &gtfsrt.TripUpdate_StopTimeUpdate{
+			StopSequence: proto.Uint32(prediction.Stop.Sequence),
+			StopId:       proto.String(prediction.Stop.ID.String()),
+			Arrival:      &gtfsrt.TripUpdate_StopTimeEvent{Time: proto.Int64(prediction.Arrival.Unix())},
+			Departure:    &gtfsrt.TripUpdate_StopTimeEvent{Time: proto.Int64(prediction.Departure.Unix())},
+		})
+	}
+	return update
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bars43ru/bus2map/api/gtfsrt"
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+	"github.com/bars43ru/bus2map/internal/service"
+)
+
+func TestGTFSRealtime_TripUpdates(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "stops.txt")
+	require.NoError(t, os.WriteFile(file, []byte("1;1;a;A;58.6;49.61\n1;2;b;B;58.6;49.62\n"), 0o644))
+	stops := repository.NewStop(file)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() { _ = stops.Run(ctx) }()
+	require.Eventually(t, func() bool {
+		_, err := stops.GetRouteStops("1")
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+
+	tracker := service.NewStopTracker(stops, service.StopRules{Radius: 30, History: 3, DefaultSpeed: 36, DefaultDwell: 10 * time.Second})
+	state := repository.NewVehicleState()
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	info := model.BusTrackingInfo{
+		Route:     model.Route{Number: "1"},
+		Transport: model.Transport{StateNumber: "A001AA"},
+		Schedule:  model.Schedule{Number: "1", From: start},
+		Location:  model.GPS{UID: "1", Time: start, Latitude: 58.6, Longitude: 49.6101},
+	}
+	tracker.Process(info)
+	state.Update(info, time.Now())
+
+	mux := http.NewServeMux()
+	NewGTFSRealtime(service.New(service.Options{State: state, Stops: tracker}), time.Hour).Register(mux)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/gtfs-rt/trip-updates", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "application/x-protobuf", rec.Header().Get("Content-Type"))
+
+	var feed gtfsrt.FeedMessage
+	require.NoError(t, proto.Unmarshal(rec.Body.Bytes(), &feed))
+	require.Equal(t, gtfsRealtimeVersion, feed.GetHeader().GetGtfsRealtimeVersion())
+	require.Len(t, feed.GetEntity(), 1)
+	update := feed.GetEntity()[0].GetTripUpdate()
+	require.Equal(t, "1-A001AA-20250101T1200", update.GetTrip().GetTripId())
+	require.Equal(t, "A001AA", update.GetVehicle().GetId())
+	require.Len(t, update.GetStopTimeUpdate(), 2)
+
+	stop := update.GetStopTimeUpdate()[0]
+	require.Equal(t, uint32(1), stop.GetStopSequence())
+	require.Equal(t, "a", stop.GetStopId())
+	require.Equal(t, start.Unix(), stop.GetArrival().GetTime(), "actual arrival at the current stop")
+	require.Equal(t, start.Add(10*time.Second).Unix(), stop.GetDeparture().GetTime())
+
+	stop = update.GetStopTimeUpdate()[1]
+	require.Equal(t, uint32(2), stop.GetStopSequence())
+	require.Equal(t, "b", stop.GetStopId())
+	// 580 м при 36 км/ч - 58 секунд после отправления
+	require.InDelta(t, start.Add(68*time.Second).Unix(), stop.GetArrival().GetTime(), 2)
+}
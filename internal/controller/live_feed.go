@@ -0,0 +1,376 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/model/transport_type"
+	"github.com/bars43ru/bus2map/internal/service"
+	"github.com/bars43ru/bus2map/pkg/xslog"
+)
+
+const (
+	feedMessageSnapshot = "snapshot"
+	feedMessageUpdate   = "update"
+	feedMessageRemove   = "remove"
+
+	sseKeepAlive = 15 * time.Second
+	// feedSweepInterval периодичность удаления у клиента транспортных средств, переставших передавать данные
+	feedSweepInterval = 10 * time.Second
+)
+
+// LiveFeed транслирует обработанные данные в браузерные клиенты по WebSocket и Server-Sent Events.
+type LiveFeed struct {
+	service        *service.BusTracking
+	activeWindow   time.Duration
+	originPatterns []string
+	sweepInterval  time.Duration
+}
+
+// NewLiveFeed создает трансляцию; в начальный снимок попадают транспортные средства,
+// от которых были данные не позднее activeWindow назад. WebSocket-соединения принимаются
+// с того же хоста, что и запрос, и с хостов, подходящих под шаблоны originPatterns (path.Match).
+func NewLiveFeed(service *service.BusTracking, activeWindow time.Duration, originPatterns []string) *LiveFeed {
+	return &LiveFeed{
+		service:        service,
+		activeWindow:   activeWindow,
+		originPatterns: originPatterns,
+		sweepInterval:  feedSweepInterval,
+	}
+}
+
+func (s *LiveFeed) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /live/ws", s.handleWebSocket)
+	mux.HandleFunc("GET /live/sse", s.handleSSE)
+}
+
+type vehicleDTO struct {
+	StateNumber   string    `json:"state_number"`
+	UID           string    `json:"uid"`
+	Type          string    `json:"type"`
+	Route         string    `json:"route"`
+	Latitude      float64   `json:"latitude"`
+	Longitude     float64   `json:"longitude"`
+	Speed         uint32    `json:"speed"`
+	Course        uint32    `json:"course"`
+	Time          time.Time `json:"time"`
+	Zones         []string  `json:"zones,omitempty"`
+	Deviation     *int64    `json:"deviation,omitempty"` // отклонение от расписания в секундах, опоздание положительное
+	Adherence     string    `json:"adherence,omitempty"`
+	ScheduleMatch string    `json:"schedule_match,omitempty"` // способ определения расписания
+}
+
+func newVehicleDTO(info model.BusTrackingInfo) vehicleDTO {
+	return vehicleDTO{
+		StateNumber:   info.Transport.StateNumber.String(),
+		UID:           info.Location.UID,
+		Type:          info.Transport.Type.String(),
+		Route:         info.Route.Number.String(),
+		Latitude:      info.Location.Latitude,
+		Longitude:     info.Location.Longitude,
+		Speed:         info.Location.Speed,
+		Course:        info.Location.Course,
+		Time:          info.Location.Time,
+		Zones:         zoneIDs(info.Zones),
+		Deviation:     deviationSeconds(info.Adherence),
+		Adherence:     info.Adherence.Status.String(),
+		ScheduleMatch: info.ScheduleMatch.String(),
+	}
+}
+
+// deviationSeconds возвращает отклонение от расписания в секундах или nil, если оно не вычислено.
+func deviationSeconds(adherence model.Adherence) *int64 {
+	if !adherence.Known {
+		return nil
+	}
+	seconds := int64(adherence.Deviation.Seconds())
+	return &seconds
+}
+
+func zoneIDs(zones []model.Zone) []string {
+	ids := make([]string, 0, len(zones))
+	for _, zone := range zones {
+		ids = append(ids, zone.ID)
+	}
+	return ids
+}
+
+type feedMessage struct {
+	Type        string       `json:"type"`
+	Vehicles    []vehicleDTO `json:"vehicles,omitempty"`
+	Vehicle     *vehicleDTO  `json:"vehicle,omitempty"`
+	StateNumber string       `json:"state_number,omitempty"`
+}
+
+// vehicleFilter условия отбора транспортных средств. Пустое условие не ограничивает выборку.
+type vehicleFilter struct {
+	Routes []string  `json:"routes"`
+	Types  []string  `json:"types"`
+	BBox   []float64 `json:"bbox"` // min_lon, min_lat, max_lon, max_lat
+	types  []transport_type.Type
+}
+
+func parseVehicleFilter(query url.Values) (vehicleFilter, error) {
+	f := vehicleFilter{
+		Routes: splitValues(query["route"]),
+		Types:  splitValues(query["type"]),
+	}
+	if v := query.Get("bbox"); v != "" {
+		for _, raw := range strings.Split(v, ",") {
+			c, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+			if err != nil {
+				return f, fmt.Errorf("invalid bbox `%s`: %w", v, err)
+			}
+			f.BBox = append(f.BBox, c)
+		}
+	}
+	return f, f.prepare()
+}
+
+func (f *vehicleFilter) prepare() error {
+	if len(f.BBox) != 0 && len(f.BBox) != 4 {
+		return fmt.Errorf("bbox must contain 4 values: min_lon,min_lat,max_lon,max_lat")
+	}
+	f.types = f.types[:0]
+	for _, v := range f.Types {
+		t, err := transport_type.ParseType(v)
+		if err != nil {
+			return fmt.Errorf("invalid type `%s`: %w", v, err)
+		}
+		f.types = append(f.types, t)
+	}
+	return nil
+}
+
+func (f vehicleFilter) Match(info model.BusTrackingInfo) bool {
+	if len(f.Routes) != 0 && !slices.Contains(f.Routes, info.Route.Number.String()) {
+		return false
+	}
+	if len(f.types) != 0 && !slices.Contains(f.types, info.Transport.Type) {
+		return false
+	}
+	if len(f.BBox) == 4 {
+		lon, lat := info.Location.Longitude, info.Location.Latitude
+		if lon < f.BBox[0] || lat < f.BBox[1] || lon > f.BBox[2] || lat > f.BBox[3] {
+			return false
+		}
+	}
+	return true
+}
+
+// splitValues разбирает значения параметра, переданные как повтором параметра, так и через запятую.
+func splitValues(values []string) []string {
+	var result []string
+	for _, v := range values {
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				result = append(result, item)
+			}
+		}
+	}
+	return result
+}
+
+// stream отправляет клиенту начальный снимок, а затем изменения по транспортным средствам, подходящим под фильтр.
+// При получении нового фильтра из filters снимок отправляется повторно. Транспортные средства, от которых нет
+// данных дольше activeWindow, удаляются у клиента.
+func (s *LiveFeed) stream(
+	ctx context.Context,
+	filter vehicleFilter,
+	filters <-chan vehicleFilter,
+	send func(msg feedMessage) error,
+) error {
+	watcher := s.service.SubscribeLocation()
+	visible := make(map[model.StateNumber]time.Time) // время последнего обновления у клиента
+
+	sendSnapshot := func() error {
+		clear(visible)
+		since := time.Now().Add(-s.activeWindow)
+		msg := feedMessage{Type: feedMessageSnapshot, Vehicles: []vehicleDTO{}}
+		for _, v := range s.service.Vehicles() {
+			if v.UpdatedAt.Before(since) || !filter.Match(v.Info) {
+				continue
+			}
+			visible[v.Info.Transport.StateNumber] = v.UpdatedAt
+			msg.Vehicles = append(msg.Vehicles, newVehicleDTO(v.Info))
+		}
+		return send(msg)
+	}
+	sendRemove := func(stateNumber model.StateNumber) error {
+		delete(visible, stateNumber)
+		return send(feedMessage{Type: feedMessageRemove, StateNumber: stateNumber.String()})
+	}
+
+	sweep := time.NewTicker(s.sweepInterval)
+	defer sweep.Stop()
+	if err := sendSnapshot(); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sweep.C:
+			since := time.Now().Add(-s.activeWindow)
+			for stateNumber, updatedAt := range visible {
+				if updatedAt.Before(since) {
+					if err := sendRemove(stateNumber); err != nil {
+						return err
+					}
+				}
+			}
+		case f := <-filters:
+			filter = f
+			if err := sendSnapshot(); err != nil {
+				return err
+			}
+		case <-watcher.Changes():
+			info := watcher.Next()
+			if info == nil {
+				continue
+			}
+			stateNumber := info.Transport.StateNumber
+			if filter.Match(*info) {
+				visible[stateNumber] = time.Now()
+				dto := newVehicleDTO(*info)
+				if err := send(feedMessage{Type: feedMessageUpdate, Vehicle: &dto}); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, ok := visible[stateNumber]; ok {
+				if err := sendRemove(stateNumber); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func (s *LiveFeed) handleSSE(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	filter, err := parseVehicleFilter(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	slog.InfoContext(ctx, "live feed sse listener connected")
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	keepAlive := time.NewTicker(sseKeepAlive)
+	defer keepAlive.Stop()
+	messages := make(chan feedMessage)
+	go func() {
+		defer cancel()
+		err := s.stream(ctx, filter, nil, func(msg feedMessage) error {
+			select {
+			case messages <- msg:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil && !errors.Is(err, context.Canceled) {
+			slog.ErrorContext(ctx, "live feed sse stream", xslog.Error(err))
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "live feed sse listener closed")
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case msg := <-messages:
+			data, err := json.Marshal(msg)
+			if err != nil {
+				slog.ErrorContext(ctx, "marshal live feed message", xslog.Error(err))
+				return
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", msg.Type, data); err != nil {
+				slog.InfoContext(ctx, "live feed sse listener closed", xslog.Error(err))
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// handleWebSocket транслирует данные по WebSocket. Клиент может сменить фильтр,
+// отправив сообщение в формате {"routes": [...], "types": [...], "bbox": [...]}.
+func (s *LiveFeed) handleWebSocket(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseVehicleFilter(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{OriginPatterns: s.originPatterns})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "accept websocket", xslog.Error(err))
+		return
+	}
+	defer conn.CloseNow()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	slog.InfoContext(ctx, "live feed websocket listener connected")
+
+	filters := make(chan vehicleFilter)
+	go func() {
+		defer cancel()
+		for {
+			var f vehicleFilter
+			if err := wsjson.Read(ctx, conn, &f); err != nil {
+				return
+			}
+			if err := f.prepare(); err != nil {
+				slog.WarnContext(ctx, "invalid live feed filter", xslog.Error(err))
+				continue
+			}
+			select {
+			case filters <- f:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	err = s.stream(ctx, filter, filters, func(msg feedMessage) error {
+		return wsjson.Write(ctx, conn, msg)
+	})
+	if err != nil && ctx.Err() == nil {
+		slog.ErrorContext(ctx, "live feed websocket stream", xslog.Error(err))
+		return
+	}
+	slog.InfoContext(ctx, "live feed websocket listener closed")
+	_ = conn.Close(websocket.StatusNormalClosure, "")
+}
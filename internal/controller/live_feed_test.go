@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+	"github.com/bars43ru/bus2map/internal/service"
+)
+
+func TestLiveFeed_Origin(t *testing.T) {
+	state := repository.NewVehicleState()
+	state.Update(model.BusTrackingInfo{
+		Transport: model.Transport{StateNumber: "A001AA"},
+		Route:     model.Route{Number: "1"},
+		Location:  model.GPS{UID: "1", Time: time.Now()},
+	}, time.Now())
+	mux := http.NewServeMux()
+	NewLiveFeed(service.New(service.Options{State: state}), time.Hour, []string{"*.example.com"}).Register(mux)
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	dial := func(origin string) (*websocket.Conn, *http.Response, error) {
+		header := http.Header{}
+		if origin != "" {
+			header.Set("Origin", origin)
+		}
+		return websocket.Dial(ctx, "ws"+srv.URL[len("http"):]+"/live/ws", &websocket.DialOptions{HTTPHeader: header})
+	}
+
+	for _, origin := range []string{"", srv.URL, "https://map.example.com"} {
+		conn, _, err := dial(origin)
+		require.NoError(t, err, origin)
+		var msg feedMessage
+		require.NoError(t, wsjson.Read(ctx, conn, &msg), origin)
+		require.Equal(t, feedMessageSnapshot, msg.Type, origin)
+		require.Len(t, msg.Vehicles, 1, origin)
+		require.Equal(t, "A001AA", msg.Vehicles[0].StateNumber, origin)
+		_ = conn.Close(websocket.StatusNormalClosure, "")
+	}
+
+	for _, origin := range []string{"https://evil.com", "https://example.com.evil.com"} {
+		_, resp, err := dial(origin)
+		require.Error(t, err, origin)
+		require.Equal(t, http.StatusForbidden, resp.StatusCode, origin)
+	}
+}
+
+func TestLiveFeed_RemoveSilent(t *testing.T) {
+	state := repository.NewVehicleState()
+	state.Update(model.BusTrackingInfo{
+		Transport: model.Transport{StateNumber: "A001AA"},
+		Location:  model.GPS{UID: "1", Time: time.Now()},
+	}, time.Now())
+	feed := NewLiveFeed(service.New(service.Options{State: state}), 100*time.Millisecond, nil)
+	feed.sweepInterval = 10 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	messages := make(chan feedMessage, 2)
+	go func() {
+		_ = feed.stream(ctx, vehicleFilter{}, nil, func(msg feedMessage) error {
+			messages <- msg
+			return nil
+		})
+	}()
+
+	msg := <-messages
+	require.Equal(t, feedMessageSnapshot, msg.Type)
+	require.Len(t, msg.Vehicles, 1)
+	msg = <-messages
+	require.Equal(t, feedMessageRemove, msg.Type, "vehicle without data longer than the active window is removed")
+	require.Equal(t, "A001AA", msg.StateNumber)
+}
@@ -3,6 +3,85 @@ package model
 type (
 	RouteNumber string
 	StateNumber string
+	// Receiver приемник, через который поступили GPS-данные
+	Receiver string
+	// DiagnosticKind вид проблемы сопоставления GPS-данных со справочниками
+	DiagnosticKind string
+	// GeofenceType назначение геозоны
+	GeofenceType string
+	// StopID идентификатор остановки
+	StopID string
+	// StopEventKind вид события на остановке
+	StopEventKind string
+	// AdherenceStatus соблюдение расписания
+	AdherenceStatus string
+	// ScheduleMatch способ определения записи расписания для GPS-данных
+	ScheduleMatch string
+	// AlertKind вид события для диспетчера
+	AlertKind string
+	// AlertState состояние события
+	AlertState string
+	// ClockCorrection источник поправки времени трекера
+	ClockCorrection string
+)
+
+const (
+	ReceiverWialonIPS Receiver = "wialon_ips"
+	ReceiverEGTS      Receiver = "egts"
+	ReceiverGRPC      Receiver = "grpc"
+)
+
+const (
+	DiagnosticUnknownUID DiagnosticKind = "unknown_uid" // UID отсутствует в справочнике транспорта
+	DiagnosticNoSchedule DiagnosticKind = "no_schedule" // для транспорта нет активного расписания
+	DiagnosticNoRoute    DiagnosticKind = "no_route"    // маршрут из расписания отсутствует в справочнике маршрутов
+)
+
+const (
+	GeofenceServiceArea GeofenceType = "service_area" // область обслуживания маршрутов
+	GeofenceDepot       GeofenceType = "depot"        // депо, стоянка
+	GeofenceNoPublish   GeofenceType = "no_publish"   // зона, данные из которой не публикуются
+)
+
+const (
+	AlertOffRoute AlertKind = "off_route" // транспорт сошел с маршрута по расписанию
+	AlertBunching AlertKind = "bunching"  // интервал до впереди идущего транспорта меньше допустимого
+	AlertGap      AlertKind = "gap"       // интервал до впереди идущего транспорта больше допустимого
+
+	AlertLateStart    AlertKind = "late_start"    // транспорт не вышел на линию к началу смены по расписанию
+	AlertNotReporting AlertKind = "not_reporting" // от транспорта нет данных с начала смены по расписанию
+	AlertWentSilent   AlertKind = "went_silent"   // транспорт перестал передавать данные во время смены
+)
+
+const (
+	ScheduleExact          ScheduleMatch = "exact"           // время GPS-данных в пределах смены
+	SchedulePreStart       ScheduleMatch = "pre_start"       // время GPS-данных в допуске до начала смены
+	SchedulePostEnd        ScheduleMatch = "post_end"        // время GPS-данных в допуске после окончания смены
+	ScheduleLastAssignment ScheduleMatch = "last_assignment" // расписание не найдено, используется последнее известное
+	ScheduleDefaultRoute   ScheduleMatch = "default_route"   // расписание не найдено, используется закрепленный маршрут
+	ScheduleOverridden     ScheduleMatch = "override"        // назначение диспетчера, имеет приоритет над расписанием
+)
+
+const (
+	ClockCorrectionNone       ClockCorrection = "none"       // время трекера не исправляется
+	ClockCorrectionAuto       ClockCorrection = "auto"       // поправка по оценке расхождения часов
+	ClockCorrectionConfigured ClockCorrection = "configured" // поправка задана в конфигурации
+)
+
+const (
+	AlertRaised  AlertState = "raised"  // событие возникло
+	AlertCleared AlertState = "cleared" // событие завершилось
+)
+
+const (
+	StopArrival   StopEventKind = "arrival"   // прибытие на остановку
+	StopDeparture StopEventKind = "departure" // отправление с остановки
+)
+
+const (
+	AdherenceOnTime AdherenceStatus = "on_time" // отклонение в допустимых пределах
+	AdherenceEarly  AdherenceStatus = "early"   // транспорт опережает расписание
+	AdherenceLate   AdherenceStatus = "late"    // транспорт отстает от расписания
 )
 
 func (s RouteNumber) String() string {
@@ -12,3 +91,43 @@ func (s RouteNumber) String() string {
 func (s StateNumber) String() string {
 	return string(s)
 }
+
+func (s Receiver) String() string {
+	return string(s)
+}
+
+func (s DiagnosticKind) String() string {
+	return string(s)
+}
+
+func (s GeofenceType) String() string {
+	return string(s)
+}
+
+func (s StopID) String() string {
+	return string(s)
+}
+
+func (s StopEventKind) String() string {
+	return string(s)
+}
+
+func (s AdherenceStatus) String() string {
+	return string(s)
+}
+
+func (s AlertKind) String() string {
+	return string(s)
+}
+
+func (s AlertState) String() string {
+	return string(s)
+}
+
+func (s ScheduleMatch) String() string {
+	return string(s)
+}
+
+func (s ClockCorrection) String() string {
+	return string(s)
+}
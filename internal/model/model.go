@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/bars43ru/bus2map/internal/model/transport_type"
+	"github.com/bars43ru/bus2map/pkg/geo"
 )
 
 type Route struct {
@@ -16,6 +17,9 @@ type Transport struct {
 	GUID        string
 	StateNumber StateNumber
 	Type        transport_type.Type
+	Priority    int       // приоритет трекера на транспорте, меньшее значение - основной трекер
+	ValidFrom   time.Time // начало действия привязки трекера к транспорту, нулевое - без ограничения
+	ValidTo     time.Time // окончание действия привязки (не входит в период), нулевое - без ограничения
 }
 
 type Schedule struct {
@@ -25,12 +29,52 @@ type Schedule struct {
 	To          time.Time
 }
 
+// ScheduleOverride назначение транспорта на маршрут диспетчером, имеющее приоритет над расписанием
+type ScheduleOverride struct {
+	ID        string
+	Schedule  Schedule  // маршрут, транспорт и период действия назначения
+	Reason    string    // причина назначения, например замена неисправного транспорта
+	CreatedBy string    // диспетчер, создавший назначение
+	CreatedAt time.Time // дата и время создания назначения
+}
+
+// ScheduleTemplate повторяющаяся запись расписания, из которой формируются смены по дням недели
+type ScheduleTemplate struct {
+	Number      RouteNumber
+	StateNumber StateNumber
+	Days        []time.Weekday // дни недели, в которые начинается смена, пусто - ежедневно
+	From        time.Duration  // время суток начала смены
+	To          time.Duration  // время суток окончания смены, если не больше From - на следующие сутки
+	ValidFrom   time.Time      // первый день действия, нулевое значение - без ограничения
+	ValidTo     time.Time      // последний день действия, нулевое значение - без ограничения
+}
+
+// CalendarException исключение производственного календаря для повторяющегося расписания
+type CalendarException struct {
+	Date    time.Time    // начало суток исключения
+	Service bool         // false - в этот день смены по повторяющемуся расписанию не начинаются
+	Weekday time.Weekday // день недели, по расписанию которого работают в этот день, если Service
+}
+
+// DefaultRoute маршрут, постоянно закрепленный за транспортом и используемый при отсутствии расписания
+type DefaultRoute struct {
+	Number      RouteNumber
+	StateNumber StateNumber
+	Days        []time.Weekday // дни недели действия, пусто - ежедневно
+	From        time.Duration  // время суток начала действия
+	To          time.Duration  // время суток окончания действия, если не больше From - на следующие сутки
+}
+
 // BusTrackingInfo содержит информацию о маршруте, текущем положении и активном расписании автобуса
 type BusTrackingInfo struct {
-	Route     Route     // Информация о маршруте
-	Transport Transport // Информация об автобусе
-	Location  GPS       // Текущие GPS-координаты
-	Schedule  Schedule  // Данные из расписания, по которому автобус движется в данный момент
+	Route         Route         // Информация о маршруте
+	Transport     Transport     // Информация об автобусе
+	Location      GPS           // Текущие GPS-координаты
+	Schedule      Schedule      // Данные из расписания, по которому автобус движется в данный момент
+	ScheduleMatch ScheduleMatch // Способ, которым определено расписание
+	Zones         []Zone        // Геозоны, в которых находится автобус
+	Match         RouteMatch    // Положение автобуса относительно линии маршрута
+	Adherence     Adherence     // Соблюдение расписания движения по остановкам
 }
 
 type GPS struct {
@@ -40,4 +84,190 @@ type GPS struct {
 	Longitude float64   // долгота
 	Speed     uint32    // скорость
 	Course    uint32    // курс
+	Receiver  Receiver  // приемник, через который получено сообщение
+	// ReceivedAt дата и время получения сообщения сервером
+	ReceivedAt time.Time
+	// SourceUID идентификатор в формате приемника, если UID преобразован в пространство имен приемника
+	SourceUID string
+}
+
+// VehicleState последнее известное состояние транспортного средства
+type VehicleState struct {
+	Info      BusTrackingInfo // Последние обработанные данные
+	UpdatedAt time.Time       // Дата и время обработки последних данных сервером
+}
+
+// RouteActivity сведения о транспортных средствах, работающих на маршруте
+type RouteActivity struct {
+	Route        Route
+	StateNumbers []StateNumber // Транспортные средства, последние данные которых получены на этом маршруте
+	LastUpdate   time.Time     // Дата и время последних данных по маршруту
+}
+
+// Diagnostic сведения о повторяющейся проблеме сопоставления GPS-данных со справочниками
+type Diagnostic struct {
+	Kind         DiagnosticKind
+	UID          string      // идентификатор в системе мониторинга
+	StateNumber  StateNumber // заполняется, если транспорт найден
+	RouteNumber  RouteNumber // заполняется для DiagnosticNoRoute
+	FirstSeen    time.Time   // дата и время первого появления проблемы
+	LastSeen     time.Time   // дата и время последнего появления проблемы
+	Count        uint64      // количество сообщений с проблемой
+	LastLocation GPS         // последние GPS-данные с проблемой
+}
+
+// Zone геозона, в которой находится транспортное средство
+type Zone struct {
+	ID   string
+	Name string
+	Type GeofenceType
+}
+
+// Geofence геозона с границами
+type Geofence struct {
+	Zone
+	Area geo.MultiPolygon
+}
+
+// RouteShape геометрия маршрута
+type RouteShape struct {
+	Number RouteNumber
+	Line   geo.Polyline
+}
+
+// RouteMatch результат привязки точки к линии маршрута
+type RouteMatch struct {
+	Matched       bool      // точка привязана к линии маршрута (отклонение в допустимых пределах)
+	HasShape      bool      // для маршрута задана геометрия
+	Position      geo.Point // ближайшая к точке позиция на линии маршрута
+	DistanceAlong float64   // расстояние от начала маршрута до Position в метрах
+	Offset        float64   // отклонение точки от линии маршрута в метрах
+}
+
+// Alert событие для диспетчера, возникающее и завершающееся по данным транспортного средства
+type Alert struct {
+	Kind        AlertKind
+	State       AlertState
+	StateNumber StateNumber
+	UID         string        // идентификатор в системе мониторинга
+	RouteNumber RouteNumber   // маршрут по расписанию
+	Since       time.Time     // дата и время возникновения события
+	Time        time.Time     // дата и время изменения состояния события
+	Location    GPS           // GPS-данные, по которым изменилось состояние события
+	Offset      float64       // отклонение от линии маршрута в метрах для AlertOffRoute
+	Headway     time.Duration // интервал до впереди идущего транспорта для AlertBunching и AlertGap
+	Leader      StateNumber   // впереди идущий транспорт для AlertBunching и AlertGap
+}
+
+// Stop остановка
+type Stop struct {
+	ID       StopID
+	Name     string
+	Location geo.Point
+}
+
+// RouteStop остановка в последовательности остановок маршрута
+type RouteStop struct {
+	Stop
+	Sequence uint32 // порядковый номер остановки на маршруте
+}
+
+// RouteStops остановки маршрута в порядке следования
+type RouteStops struct {
+	Number RouteNumber
+	Stops  []RouteStop
+}
+
+// StopEvent прибытие транспорта на остановку или отправление с нее
+type StopEvent struct {
+	Kind        StopEventKind
+	StateNumber StateNumber
+	UID         string
+	RouteNumber RouteNumber
+	Stop        RouteStop
+	Time        time.Time // дата и время события по GPS-данным
+}
+
+// StopPrediction прогноз прибытия и отправления транспорта на остановке
+type StopPrediction struct {
+	Stop      RouteStop
+	Arrival   time.Time // для остановки, на которой находится транспорт, - фактическое время прибытия
+	Departure time.Time
+}
+
+// TripProgress положение транспорта в последовательности остановок маршрута и прогноз по следующим остановкам
+type TripProgress struct {
+	StateNumber StateNumber
+	UID         string
+	RouteNumber RouteNumber
+	Schedule    Schedule
+	LastStop    RouteStop // последняя остановка, на которую прибыл транспорт
+	AtStop      bool      // транспорт находится на LastStop
+	Predictions []StopPrediction
+	UpdatedAt   time.Time // дата и время GPS-данных, по которым построен прогноз
+}
+
+// Trip рейс расписания движения с плановым временем отправления от остановок
+type Trip struct {
+	ID          string
+	RouteNumber RouteNumber
+	StateNumber StateNumber // если задан, рейс выполняется только этим транспортом
+	Stops       []TripStop  // в порядке следования
+}
+
+// TripStop плановое время отправления от остановки рейса
+type TripStop struct {
+	Sequence  uint32
+	StopID    StopID
+	Departure time.Time
+}
+
+// Adherence отклонение от расписания движения по остановкам
+type Adherence struct {
+	Known     bool          // рейс расписания определен и отклонение вычислено
+	TripID    string        // рейс расписания, с которым сравнивается движение
+	Deviation time.Duration // положительное значение - опоздание, отрицательное - опережение
+	Status    AdherenceStatus
+}
+
+// VehicleHeadway интервал транспорта до впереди идущего на маршруте
+type VehicleHeadway struct {
+	StateNumber   StateNumber
+	Leader        StateNumber   // впереди идущий транспорт, пусто для первого
+	DistanceAlong float64       // расстояние от начала маршрута в метрах
+	Headway       time.Duration // время с момента прохождения этого места впереди идущим транспортом
+	Known         bool          // интервал вычислен
+}
+
+// RouteHeadway интервалы движения на маршруте
+type RouteHeadway struct {
+	RouteNumber    RouteNumber
+	Planned        time.Duration
+	Vehicles       []VehicleHeadway // в порядке убывания расстояния от начала маршрута
+	Mean           time.Duration    // средний интервал по транспорту с известным интервалом
+	Min            time.Duration
+	Max            time.Duration
+	BunchingEvents uint64 // количество событий сближения с момента запуска
+	GapEvents      uint64 // количество событий разрыва с момента запуска
+}
+
+// MissingVehicle транспортное средство, которое должно работать по расписанию, но не передает данные
+type MissingVehicle struct {
+	StateNumber StateNumber
+	Schedule    Schedule  // активная запись расписания
+	Kind        AlertKind // AlertLateStart, AlertNotReporting или AlertWentSilent
+	LastSeen    time.Time // дата и время последнего получения данных, нулевое если данных не было
+	Since       time.Time // дата и время, с которого транспорт считается отсутствующим
+}
+
+// ClockSkew расхождение часов трекера со временем сервера
+type ClockSkew struct {
+	UID        string
+	Samples    int             // количество сообщений в окне оценки
+	Skew       time.Duration   // оценка опережения часов трекера, отрицательная при отставании
+	MinSkew    time.Duration   // наименьшее расхождение времени сообщения и времени получения в окне
+	MaxSkew    time.Duration   // наибольшее расхождение времени сообщения и времени получения в окне
+	Offset     time.Duration   // поправка, прибавляемая ко времени сообщений
+	Correction ClockCorrection // источник поправки
+	UpdatedAt  time.Time       // дата и время получения последнего сообщения
 }
@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const signaturePrefix = "sha256="
+
+type Client struct {
+	url             string
+	method          string
+	headers         map[string]string
+	secret          []byte
+	signatureHeader string
+}
+
+// New создает клиента для отправки данных на произвольный HTTP-адрес.
+// Если задан secret, тело запроса подписывается HMAC-SHA256 и подпись передается в заголовке signatureHeader.
+func New(url string, method string, headers map[string]string, secret string, signatureHeader string) *Client {
+	if method == "" {
+		method = http.MethodPost
+	}
+	return &Client{
+		url:             url,
+		method:          method,
+		headers:         headers,
+		secret:          []byte(secret),
+		signatureHeader: signatureHeader,
+	}
+}
+
+func (c *Client) Send(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, c.method, c.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("prepare request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+	if len(c.secret) != 0 && c.signatureHeader != "" {
+		req.Header.Set(c.signatureHeader, c.Sign(body))
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("code status response %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign возвращает подпись тела запроса в формате `sha256=<hex>`.
+func (c *Client) Sign(body []byte) string {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
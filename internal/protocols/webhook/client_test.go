@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientSend(t *testing.T) {
+	const (
+		secret = "secret"
+		body   = `{"vehicle":"A001AA"}`
+	)
+	var (
+		gotBody      []byte
+		gotMethod    string
+		gotHeader    string
+		gotSignature string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotBody, _ = io.ReadAll(r.Body)
+		gotMethod = r.Method
+		gotHeader = r.Header.Get("X-Token")
+		gotSignature = r.Header.Get("X-Signature")
+	}))
+	defer srv.Close()
+
+	cli := New(srv.URL, http.MethodPut, map[string]string{"X-Token": "token"}, secret, "X-Signature")
+	require.NoError(t, cli.Send(context.Background(), []byte(body)))
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	require.Equal(t, body, string(gotBody))
+	require.Equal(t, http.MethodPut, gotMethod)
+	require.Equal(t, "token", gotHeader)
+	require.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), gotSignature)
+}
+
+func TestClientSendUnsigned(t *testing.T) {
+	var gotSignature string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSignature = r.Header.Get("X-Signature")
+	}))
+	defer srv.Close()
+
+	cli := New(srv.URL, "", nil, "", "X-Signature")
+	require.NoError(t, cli.Send(context.Background(), []byte("{}")))
+	require.Empty(t, gotSignature)
+}
+
+func TestClientSendErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	cli := New(srv.URL, "", nil, "", "")
+	require.Error(t, cli.Send(context.Background(), []byte("{}")))
+}
+
+func TestTemplateExecute(t *testing.T) {
+	tmpl, err := ParseTemplate("test", `{"id":{{ json .ID }},"time":{{ unix .Time }},"iso":{{ json (rfc3339 .Time) }}}`)
+	require.NoError(t, err)
+
+	body, err := tmpl.Execute(struct {
+		ID   string
+		Time time.Time
+	}{
+		ID:   `A"1`,
+		Time: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id":"A\"1","time":1735787045,"iso":"2025-01-02T03:04:05Z"}`, string(body))
+}
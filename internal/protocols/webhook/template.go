@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/template"
+	"time"
+)
+
+// Funcs функции, доступные в шаблоне тела запроса.
+var Funcs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	},
+	"unix": func(t time.Time) int64 {
+		return t.Unix()
+	},
+	"rfc3339": func(t time.Time) string {
+		return t.UTC().Format(time.RFC3339)
+	},
+}
+
+type Template struct {
+	tmpl *template.Template
+}
+
+func ParseTemplate(name string, text string) (*Template, error) {
+	tmpl, err := template.New(name).Funcs(Funcs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse template %s: %w", name, err)
+	}
+	return &Template{tmpl: tmpl}, nil
+}
+
+func ParseTemplateFile(file string) (*Template, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read template file: %w", err)
+	}
+	return ParseTemplate(file, string(b))
+}
+
+func (t *Template) Execute(data any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := t.tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("execute template: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/bars43ru/bus2map/internal/model"
 	"github.com/bars43ru/bus2map/internal/protocols/egts"
@@ -15,12 +16,14 @@ func BridgeEGTS(gpsLocator GPSLocator) tcp.ConnectionHandlerFunc {
 		datasource := egts.NewParse(r)
 		for _, point := range datasource.Points(ctx) {
 			rawGPS := model.GPS{
-				UID:       fmt.Sprintf("%d", point.Client),
-				Time:      point.Time,
-				Latitude:  point.Latitude,
-				Longitude: point.Longitude,
-				Speed:     uint32(point.Speed),
-				Course:    uint32(point.Course),
+				UID:        fmt.Sprintf("%d", point.Client),
+				Time:       point.Time,
+				Latitude:   point.Latitude,
+				Longitude:  point.Longitude,
+				Speed:      uint32(point.Speed),
+				Course:     uint32(point.Course),
+				Receiver:   model.ReceiverEGTS,
+				ReceivedAt: time.Now(),
 			}
 			gpsLocator.ProcessGPSData(ctx, rawGPS)
 		}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/bars43ru/bus2map/internal/model"
 	"github.com/bars43ru/bus2map/internal/protocols/wialonips"
@@ -18,12 +19,14 @@ func BridgeWialonIPS(gpsLocator GPSLocator) tcp.ConnectionHandlerFunc {
 		}
 		for _, point := range datasource.Points(ctx) {
 			rawGPS := model.GPS{
-				UID:       point.UID,
-				Time:      point.Time,
-				Latitude:  point.Latitude.ToWgs84(),
-				Longitude: point.Longitude.ToWgs84(),
-				Speed:     uint32(point.Speed),
-				Course:    uint32(point.Course),
+				UID:        point.UID,
+				Time:       point.Time,
+				Latitude:   point.Latitude.ToWgs84(),
+				Longitude:  point.Longitude.ToWgs84(),
+				Speed:      uint32(point.Speed),
+				Course:     uint32(point.Course),
+				Receiver:   model.ReceiverWialonIPS,
+				ReceivedAt: time.Now(),
 			}
 			gpsLocator.ProcessGPSData(ctx, rawGPS)
 		}
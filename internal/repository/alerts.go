@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/bars43ru/bus2map/internal/model"
+)
+
+type alertKey struct {
+	kind        model.AlertKind
+	stateNumber model.StateNumber
+}
+
+// alertRecord строка журнала событий
+type alertRecord struct {
+	Kind        string    `json:"kind"`
+	State       string    `json:"state"`
+	StateNumber string    `json:"state_number"`
+	UID         string    `json:"uid"`
+	Route       string    `json:"route"`
+	Since       time.Time `json:"since"`
+	Time        time.Time `json:"time"`
+	Latitude    float64   `json:"latitude"`
+	Longitude   float64   `json:"longitude"`
+	Offset      float64   `json:"offset,omitempty"`
+	Headway     float64   `json:"headway,omitempty"` // секунд
+	Leader      string    `json:"leader,omitempty"`
+}
+
+// Alerts хранит активные события и последние изменения их состояния,
+// каждое изменение записывается в журнал в формате JSON Lines.
+type Alerts struct {
+	mu       sync.Mutex
+	log      io.Writer
+	capacity int
+	active   map[alertKey]model.Alert
+	history  []model.Alert
+}
+
+// NewAlerts создает хранилище событий, в памяти хранится не более capacity последних изменений.
+// Если log равен nil, журнал не ведется.
+func NewAlerts(log io.Writer, capacity int) *Alerts {
+	return &Alerts{
+		log:      log,
+		capacity: capacity,
+		active:   make(map[alertKey]model.Alert),
+	}
+}
+
+// Add учитывает изменение состояния события.
+func (s *Alerts) Add(alert model.Alert) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := alertKey{kind: alert.Kind, stateNumber: alert.StateNumber}
+	if alert.State == model.AlertCleared {
+		delete(s.active, key)
+	} else {
+		s.active[key] = alert
+	}
+	s.history = append(s.history, alert)
+	if len(s.history) > s.capacity {
+		s.history = slices.Delete(s.history, 0, len(s.history)-s.capacity)
+	}
+
+	if s.log == nil {
+		return nil
+	}
+	b, err := json.Marshal(alertRecord{
+		Kind:        alert.Kind.String(),
+		State:       alert.State.String(),
+		StateNumber: alert.StateNumber.String(),
+		UID:         alert.UID,
+		Route:       alert.RouteNumber.String(),
+		Since:       alert.Since,
+		Time:        alert.Time,
+		Latitude:    alert.Location.Latitude,
+		Longitude:   alert.Location.Longitude,
+		Offset:      alert.Offset,
+		Headway:     alert.Headway.Seconds(),
+		Leader:      alert.Leader.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal alert: %w", err)
+	}
+	if _, err := s.log.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("write alert log: %w", err)
+	}
+	return nil
+}
+
+// Active возвращает события, которые еще не завершились, в порядке возникновения.
+func (s *Alerts) Active() []model.Alert {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	items := make([]model.Alert, 0, len(s.active))
+	for _, alert := range s.active {
+		items = append(items, alert)
+	}
+	slices.SortFunc(items, func(a, b model.Alert) int {
+		return a.Since.Compare(b.Since)
+	})
+	return items
+}
+
+// History возвращает последние изменения состояния событий в порядке добавления.
+func (s *Alerts) History() []model.Alert {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.history)
+}
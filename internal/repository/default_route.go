@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/yaacov/observer/observer"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/pkg/xslog"
+)
+
+const patternDefaultRoute = `(?P<transport>[^;]+);(?P<route>[^;]+);(?P<days>[^;]*);(?P<hours>[^;]*)`
+
+// DefaultRoute справочник маршрутов, постоянно закрепленных за транспортом. Используется, когда для транспорта
+// нет расписания. Каждая строка: `госномер;номер маршрута;дни недели;часы действия`.
+// Дни недели задаются номерами от 1 (понедельник) до 7 (воскресенье) и диапазонами через запятую, например `1-5,7`,
+// часы действия - интервалом `22:00-06:00`, который может переходить через полночь.
+// Пустые дни недели или часы означают действие ежедневно или круглосуточно. Время указывается в часовом поясе location.
+type DefaultRoute struct {
+	file     string
+	location *time.Location
+	regex    *regexp.Regexp
+	data     SafeMapAtomic[model.StateNumber, []model.DefaultRoute]
+}
+
+func NewDefaultRoute(file string, location *time.Location) *DefaultRoute {
+	return &DefaultRoute{
+		file:     file,
+		location: location,
+		regex:    regexp.MustCompile(patternDefaultRoute),
+		data:     NewSafeMapAtomic[model.StateNumber, []model.DefaultRoute](),
+	}
+}
+
+// GetCurrent возвращает запись расписания по маршруту, закрепленному за транспортом в момент currentTime.
+// Начало и окончание записи соответствуют текущему периоду действия закрепления.
+func (s *DefaultRoute) GetCurrent(stateNumber model.StateNumber, currentTime time.Time) (model.Schedule, error) {
+	items, ok := s.data.Get(stateNumber)
+	if !ok {
+		return model.Schedule{}, ErrNotFound
+	}
+	for _, item := range items {
+		if from, to, ok := dailyWindow(currentTime.In(s.location), item.Days, item.From, item.To); ok {
+			return model.Schedule{
+				Number:      item.Number,
+				StateNumber: item.StateNumber,
+				From:        from,
+				To:          to,
+			}, nil
+		}
+	}
+	return model.Schedule{}, ErrNotFound
+}
+
+func (s *DefaultRoute) Run(ctx context.Context) error {
+	o := observer.Observer{}
+	err := o.Watch([]string{s.file})
+	if err != nil {
+		return fmt.Errorf("subscribe watch %s: %w", s.file, err)
+	}
+	defer func(o *observer.Observer) {
+		err := o.Close()
+		if err != nil {
+			slog.ErrorContext(ctx, "close file change watch", xslog.Error(err))
+		}
+	}(&o)
+
+	replaceDatasource := func() {
+		routes, err := s.readFromFile(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "load datasource default route", xslog.Error(err))
+			return
+		}
+		s.replace(routes)
+	}
+
+	o.AddListener(func(e interface{}) {
+		slog.InfoContext(ctx, fmt.Sprintf("file modified: %v", e))
+		replaceDatasource()
+	})
+	replaceDatasource()
+	<-ctx.Done()
+	return nil
+}
+
+func (s *DefaultRoute) replace(routes []model.DefaultRoute) {
+	data := make(map[model.StateNumber][]model.DefaultRoute)
+	for _, route := range routes {
+		data[route.StateNumber] = append(data[route.StateNumber], route)
+	}
+	s.data.Replace(data)
+}
+
+func (s *DefaultRoute) readFromFile(ctx context.Context) ([]model.DefaultRoute, error) {
+	file, err := os.Open(s.file)
+	if err != nil {
+		return nil, err
+	}
+	defer func(*os.File) {
+		if err := file.Close(); err != nil {
+			slog.ErrorContext(ctx, "close file",
+				slog.String("file", s.file),
+				xslog.Error(err),
+			)
+		}
+	}(file)
+
+	var routes []model.DefaultRoute
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		record := scanner.Text()
+		if strings.TrimSpace(record) == "" {
+			continue
+		}
+
+		route, err := s.parseRawRecord(record)
+		if err != nil {
+			return nil, fmt.Errorf("parse raw record `%s`: %w", record, err)
+		}
+
+		routes = append(routes, route)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return routes, nil
+}
+
+// parseRawRecord парсит строку в закрепленный маршрут
+func (s *DefaultRoute) parseRawRecord(record string) (model.DefaultRoute, error) {
+	match := s.regex.FindStringSubmatch(record)
+	if match == nil {
+		return model.DefaultRoute{}, fmt.Errorf(
+			"raw record `%s` doesn't match the format `%s`", record, patternDefaultRoute)
+	}
+
+	groupNames := s.regex.SubexpNames()
+	result := model.DefaultRoute{}
+
+	for i, name := range groupNames {
+		if i != 0 {
+			switch name {
+			case "transport":
+				result.StateNumber = model.StateNumber(match[i])
+			case "route":
+				result.Number = model.RouteNumber(match[i])
+			case "days":
+				days, err := parseWeekdays(match[i])
+				if err != nil {
+					return result, fmt.Errorf("parse days: %w", err)
+				}
+				result.Days = days
+			case "hours":
+				from, to, err := parseHours(match[i])
+				if err != nil {
+					return result, fmt.Errorf("parse hours: %w", err)
+				}
+				result.From, result.To = from, to
+			}
+		}
+	}
+	return result, nil
+}
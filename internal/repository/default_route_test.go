@@ -0,0 +1,62 @@
+package repository_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+)
+
+func TestDefaultRoute_GetCurrent(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "default_route.txt")
+	data := "A001AA;night;1-5;22:00-06:00\n" +
+		"A001AA;airport;6,7;\n" +
+		"B002BB;shuttle;;\n"
+	require.NoError(t, os.WriteFile(file, []byte(data), 0o644))
+
+	location := time.FixedZone("UTC+3", 3*60*60)
+	routes := repository.NewDefaultRoute(file, location)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- routes.Run(ctx) }()
+	require.Eventually(t, func() bool {
+		_, err := routes.GetCurrent("B002BB", time.Now())
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+
+	// 2025-01-03 - пятница
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, 1, day, hour, minute, 0, 0, location)
+	}
+	tests := []struct {
+		at       time.Time
+		number   model.RouteNumber
+		from, to time.Time
+	}{
+		{at: at(3, 23, 0), number: "night", from: at(3, 22, 0), to: at(4, 6, 0)},
+		{at: at(4, 5, 59), number: "night", from: at(3, 22, 0), to: at(4, 6, 0)},
+		{at: at(4, 6, 0), number: "airport", from: at(4, 0, 0), to: at(5, 0, 0)},
+		{at: at(5, 23, 0), number: "airport", from: at(5, 0, 0), to: at(6, 0, 0)},
+		{at: at(6, 3, 0)},
+		{at: at(3, 12, 0)},
+	}
+	for _, tt := range tests {
+		schedule, err := routes.GetCurrent("A001AA", tt.at.UTC())
+		if tt.number == "" {
+			require.ErrorIs(t, err, repository.ErrNotFound, tt.at)
+			continue
+		}
+		require.NoError(t, err, tt.at)
+		require.Equal(t, tt.number, schedule.Number, tt.at)
+		require.True(t, tt.from.Equal(schedule.From), "%v: from %v", tt.at, schedule.From)
+		require.True(t, tt.to.Equal(schedule.To), "%v: to %v", tt.at, schedule.To)
+	}
+}
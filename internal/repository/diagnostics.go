@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/bars43ru/bus2map/internal/model"
+)
+
+type diagnosticKey struct {
+	kind model.DiagnosticKind
+	key  string
+}
+
+// Diagnostics накапливает в памяти сведения о GPS-данных, которые не удалось сопоставить
+// со справочниками транспорта, расписания и маршрутов.
+type Diagnostics struct {
+	ttl      time.Duration
+	capacity int
+	mu       sync.Mutex
+	data     map[diagnosticKey]*model.Diagnostic
+}
+
+// NewDiagnostics создает хранилище сведений, в котором хранится не более capacity записей;
+// запись удаляется, если проблема не повторялась дольше ttl, например после исправления справочника.
+// При переполнении удаляется запись, проблема которой появлялась раньше остальных.
+func NewDiagnostics(ttl time.Duration, capacity int) *Diagnostics {
+	return &Diagnostics{
+		ttl:      ttl,
+		capacity: capacity,
+		data:     make(map[diagnosticKey]*model.Diagnostic),
+	}
+}
+
+// Record учитывает очередное появление проблемы. Записи группируются по виду проблемы и
+// идентификатору, который нужно исправить в справочнике: UID, госномеру или номеру маршрута.
+func (s *Diagnostics) Record(
+	kind model.DiagnosticKind,
+	stateNumber model.StateNumber,
+	routeNumber model.RouteNumber,
+	gps model.GPS,
+	seenAt time.Time,
+) {
+	key := diagnosticKey{kind: kind}
+	switch kind {
+	case model.DiagnosticNoSchedule:
+		key.key = stateNumber.String()
+	case model.DiagnosticNoRoute:
+		key.key = routeNumber.String()
+	default:
+		key.key = gps.UID
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	item, ok := s.data[key]
+	if !ok {
+		s.expire(seenAt)
+		if len(s.data) >= s.capacity {
+			s.evictOldest()
+		}
+		item = &model.Diagnostic{
+			Kind:      kind,
+			FirstSeen: seenAt,
+		}
+		s.data[key] = item
+	}
+	item.UID = gps.UID
+	item.StateNumber = stateNumber
+	item.RouteNumber = routeNumber
+	item.LastSeen = seenAt
+	item.LastLocation = gps
+	item.Count++
+}
+
+// List возвращает записи указанных видов (всех, если виды не заданы), действующие в момент now,
+// начиная с последних появившихся.
+func (s *Diagnostics) List(now time.Time, kinds ...model.DiagnosticKind) []model.Diagnostic {
+	s.mu.Lock()
+	s.expire(now)
+	items := make([]model.Diagnostic, 0, len(s.data))
+	for key, item := range s.data {
+		if len(kinds) != 0 && !slices.Contains(kinds, key.kind) {
+			continue
+		}
+		items = append(items, *item)
+	}
+	s.mu.Unlock()
+
+	slices.SortFunc(items, func(a, b model.Diagnostic) int {
+		return b.LastSeen.Compare(a.LastSeen)
+	})
+	return items
+}
+
+// expire удаляет записи, проблема которых не повторялась дольше ttl к моменту now.
+func (s *Diagnostics) expire(now time.Time) {
+	for key, item := range s.data {
+		if now.Sub(item.LastSeen) > s.ttl {
+			delete(s.data, key)
+		}
+	}
+}
+
+func (s *Diagnostics) evictOldest() {
+	var (
+		oldest diagnosticKey
+		found  bool
+	)
+	for key, item := range s.data {
+		if !found || item.LastSeen.Before(s.data[oldest].LastSeen) {
+			oldest, found = key, true
+		}
+	}
+	if found {
+		delete(s.data, oldest)
+	}
+}
@@ -0,0 +1,45 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+)
+
+func TestDiagnostics(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time {
+		return start.Add(time.Duration(minutes) * time.Minute)
+	}
+	diag := repository.NewDiagnostics(time.Hour, 3)
+	record := func(uid string, minutes int) {
+		diag.Record(model.DiagnosticUnknownUID, "", "", model.GPS{UID: uid}, at(minutes))
+	}
+	uids := func(now time.Time) []string {
+		var items []string
+		for _, item := range diag.List(now) {
+			items = append(items, item.UID)
+		}
+		return items
+	}
+
+	record("1", 0)
+	record("2", 10)
+	record("1", 20)
+	diag.Record(model.DiagnosticNoSchedule, "A001AA", "", model.GPS{UID: "3"}, at(30))
+	require.Equal(t, []string{"3", "1", "2"}, uids(at(30)))
+	items := diag.List(at(30), model.DiagnosticUnknownUID)
+	require.Len(t, items, 2)
+	require.Equal(t, uint64(2), items[0].Count)
+	require.Equal(t, at(0), items[0].FirstSeen)
+
+	record("4", 40)
+	require.Equal(t, []string{"4", "3", "1"}, uids(at(40)), "the least recently seen record is evicted")
+
+	require.Equal(t, []string{"4", "3"}, uids(at(81)), "records expire after ttl")
+	require.Empty(t, uids(at(200)))
+}
@@ -5,7 +5,14 @@ import "errors"
 var ErrNotFound = errors.New("not found")
 
 const (
-	FileDatasourceRoute     = "./datasource/route.txt"
-	FileDatasourceSchedule  = "./datasource/schedule.txt"
-	FileDatasourceTransport = "./datasource/transport.txt"
+	FileDatasourceRoute            = "./datasource/route.txt"
+	FileDatasourceSchedule         = "./datasource/schedule.txt"
+	FileDatasourceScheduleTemplate = "./datasource/schedule_template.txt"
+	FileDatasourceCalendar         = "./datasource/calendar.txt"
+	FileDatasourceTransport        = "./datasource/transport.txt"
+	FileDatasourceGeofence         = "./datasource/geofence.geojson"
+	FileDatasourceStop             = "./datasource/stops.txt"
+	FileDatasourceTimetable        = "./datasource/timetable.txt"
+	FileDatasourceDefaultRoute     = "./datasource/default_route.txt"
+	DirDatasourceRouteShape        = "./datasource/shapes"
 )
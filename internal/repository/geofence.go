@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync/atomic"
+
+	"github.com/yaacov/observer/observer"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/pkg/geo"
+	"github.com/bars43ru/bus2map/pkg/xslog"
+)
+
+// Geofence справочник геозон, загружаемый из файла GeoJSON. Каждый объект FeatureCollection
+// должен иметь геометрию Polygon или MultiPolygon и свойства id, name и type (service_area, depot, no_publish).
+type Geofence struct {
+	file string
+	data atomic.Pointer[[]model.Geofence]
+}
+
+func NewGeofence(file string) *Geofence {
+	s := &Geofence{
+		file: file,
+	}
+	s.data.Store(&[]model.Geofence{})
+	return s
+}
+
+// Find возвращает геозоны, содержащие точку.
+func (s *Geofence) Find(point geo.Point) []model.Zone {
+	var zones []model.Zone
+	for _, item := range *s.data.Load() {
+		if item.Area.Contains(point) {
+			zones = append(zones, item.Zone)
+		}
+	}
+	return zones
+}
+
+// HasType проверяет, загружена ли хотя бы одна геозона указанного типа.
+func (s *Geofence) HasType(t model.GeofenceType) bool {
+	for _, item := range *s.data.Load() {
+		if item.Type == t {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Geofence) Replace(geofences []model.Geofence) {
+	s.data.Store(&geofences)
+}
+
+func (s *Geofence) Run(ctx context.Context) error {
+	o := observer.Observer{}
+	err := o.Watch([]string{s.file})
+	if err != nil {
+		return fmt.Errorf("subscribe watch %s: %w", s.file, err)
+	}
+	defer func(o *observer.Observer) {
+		err := o.Close()
+		if err != nil {
+			slog.ErrorContext(ctx, "close file change watch", xslog.Error(err))
+		}
+	}(&o)
+
+	replaceDatasource := func() {
+		geofences, err := s.readFromFile()
+		if err != nil {
+			slog.ErrorContext(ctx, "load datasource geofence", xslog.Error(err))
+			return
+		}
+		s.Replace(geofences)
+	}
+
+	o.AddListener(func(e interface{}) {
+		slog.InfoContext(ctx, fmt.Sprintf("file modified: %v", e))
+		replaceDatasource()
+	})
+	replaceDatasource()
+	<-ctx.Done()
+	return nil
+}
+
+func (s *Geofence) readFromFile() ([]model.Geofence, error) {
+	b, err := os.ReadFile(s.file)
+	if err != nil {
+		return nil, err
+	}
+	var collection geo.FeatureCollection
+	if err := json.Unmarshal(b, &collection); err != nil {
+		return nil, fmt.Errorf("unmarshal geojson: %w", err)
+	}
+
+	geofences := make([]model.Geofence, 0, len(collection.Features))
+	for i, feature := range collection.Features {
+		geofence, err := s.parseFeature(feature)
+		if err != nil {
+			return nil, fmt.Errorf("parse feature #%d: %w", i, err)
+		}
+		geofences = append(geofences, geofence)
+	}
+	return geofences, nil
+}
+
+func (s *Geofence) parseFeature(feature geo.Feature) (model.Geofence, error) {
+	area, err := feature.Geometry.MultiPolygon()
+	if err != nil {
+		return model.Geofence{}, fmt.Errorf("geometry: %w", err)
+	}
+	geofence := model.Geofence{
+		Zone: model.Zone{
+			ID:   feature.Property("id"),
+			Name: feature.Property("name"),
+			Type: model.GeofenceType(feature.Property("type")),
+		},
+		Area: area,
+	}
+	if geofence.ID == "" && feature.ID != nil {
+		geofence.ID = fmt.Sprint(feature.ID)
+	}
+	switch geofence.Type {
+	case model.GeofenceServiceArea, model.GeofenceDepot, model.GeofenceNoPublish:
+	default:
+		return model.Geofence{}, fmt.Errorf("unexpected value `%s` for `type`", geofence.Type)
+	}
+	return geofence, nil
+}
@@ -0,0 +1,47 @@
+package repository_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+	"github.com/bars43ru/bus2map/pkg/geo"
+)
+
+func TestGeofence_Load(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "geofences.geojson")
+	geojson := `{"type":"FeatureCollection","features":[
+		{"type":"Feature","properties":{"id":"city","name":"Город","type":"service_area"},
+			"geometry":{"type":"Polygon","coordinates":[[[49.5,58.5],[49.8,58.5],[49.8,58.7],[49.5,58.7],[49.5,58.5]]]}},
+		{"type":"Feature","id":7,"properties":{"name":"Депо","type":"depot"},
+			"geometry":{"type":"MultiPolygon","coordinates":[[[[49.6,58.6],[49.61,58.6],[49.61,58.61],[49.6,58.61],[49.6,58.6]]]]}}
+	]}`
+	require.NoError(t, os.WriteFile(file, []byte(geojson), 0o644))
+
+	geofence := repository.NewGeofence(file)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- geofence.Run(ctx) }()
+	require.Eventually(t, func() bool {
+		return geofence.HasType(model.GeofenceDepot)
+	}, time.Second, 10*time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+
+	require.True(t, geofence.HasType(model.GeofenceServiceArea))
+	require.False(t, geofence.HasType(model.GeofenceNoPublish))
+	require.Equal(t, []model.Zone{
+		{ID: "city", Name: "Город", Type: model.GeofenceServiceArea},
+		{ID: "7", Name: "Депо", Type: model.GeofenceDepot},
+	}, geofence.Find(geo.Point{Latitude: 58.605, Longitude: 49.605}))
+	require.Equal(t, []model.Zone{
+		{ID: "city", Name: "Город", Type: model.GeofenceServiceArea},
+	}, geofence.Find(geo.Point{Latitude: 58.65, Longitude: 49.7}))
+	require.Empty(t, geofence.Find(geo.Point{Latitude: 58.8, Longitude: 49.7}))
+}
@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/bars43ru/bus2map/internal/model"
+)
+
+const (
+	overrideActionCreate = "create"
+	overrideActionDelete = "delete"
+)
+
+// overrideRecord назначение диспетчера в файле хранения и журнале аудита
+type overrideRecord struct {
+	ID          string    `json:"id"`
+	Route       string    `json:"route"`
+	StateNumber string    `json:"state_number"`
+	From        time.Time `json:"from"`
+	To          time.Time `json:"to"`
+	Reason      string    `json:"reason,omitempty"`
+	CreatedBy   string    `json:"created_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// overrideAuditRecord строка журнала аудита изменений назначений
+type overrideAuditRecord struct {
+	Action   string         `json:"action"`
+	Operator string         `json:"operator"`
+	Time     time.Time      `json:"time"`
+	Override overrideRecord `json:"override"`
+}
+
+// Override назначения транспорта на маршруты диспетчером. Назначения хранятся в файле в формате JSON
+// и переживают перезапуск, каждое изменение записывается в журнал аудита в формате JSON Lines
+// до сохранения, поэтому изменение без записи в журнале не применяется.
+// Назначения с истекшим периодом действия удаляются из файла при следующем изменении.
+type Override struct {
+	file  string
+	audit io.Writer
+	mu    sync.RWMutex
+	data  map[string]model.ScheduleOverride
+}
+
+// NewOverride создает хранилище назначений в файле file и загружает сохраненные назначения;
+// отсутствие файла означает, что назначений нет. Если audit равен nil, журнал аудита не ведется.
+func NewOverride(file string, audit io.Writer) (*Override, error) {
+	s := &Override{
+		file:  file,
+		audit: audit,
+	}
+	data, err := s.readFromFile()
+	if err != nil {
+		return nil, fmt.Errorf("load overrides %s: %w", file, err)
+	}
+	s.data = data
+	return s, nil
+}
+
+// GetCurrent возвращает назначение транспорта, действующее в момент currentTime.
+// Из нескольких действующих назначений выбирается созданное последним.
+func (s *Override) GetCurrent(stateNumber model.StateNumber, currentTime time.Time) (model.Schedule, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var (
+		current model.ScheduleOverride
+		found   bool
+	)
+	for _, item := range s.data {
+		if item.Schedule.StateNumber != stateNumber ||
+			item.Schedule.From.After(currentTime) || item.Schedule.To.Before(currentTime) {
+			continue
+		}
+		if !found || item.CreatedAt.After(current.CreatedAt) {
+			current, found = item, true
+		}
+	}
+	if !found {
+		return model.Schedule{}, ErrNotFound
+	}
+	return current.Schedule, nil
+}
+
+// ListActive возвращает назначения всех транспортных средств, действующие в момент currentTime;
+// из нескольких действующих назначений транспорта выбирается созданное последним.
+func (s *Override) ListActive(currentTime time.Time) []model.Schedule {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	current := make(map[model.StateNumber]model.ScheduleOverride)
+	for _, item := range s.data {
+		if item.Schedule.From.After(currentTime) || item.Schedule.To.Before(currentTime) {
+			continue
+		}
+		if v, ok := current[item.Schedule.StateNumber]; !ok || item.CreatedAt.After(v.CreatedAt) {
+			current[item.Schedule.StateNumber] = item
+		}
+	}
+	result := make([]model.Schedule, 0, len(current))
+	for _, item := range current {
+		result = append(result, item.Schedule)
+	}
+	return result
+}
+
+// List возвращает назначения, действующие в момент currentTime или позже, упорядоченные по началу действия.
+func (s *Override) List(currentTime time.Time) []model.ScheduleOverride {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	items := make([]model.ScheduleOverride, 0, len(s.data))
+	for _, item := range s.data {
+		if !item.Schedule.To.Before(currentTime) {
+			items = append(items, item)
+		}
+	}
+	slices.SortFunc(items, func(a, b model.ScheduleOverride) int {
+		return a.Schedule.From.Compare(b.Schedule.From)
+	})
+	return items
+}
+
+// Create сохраняет новое назначение, присваивая ему идентификатор.
+func (s *Override) Create(override model.ScheduleOverride) (model.ScheduleOverride, error) {
+	override.ID = rand.Text()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data := s.actual(override.CreatedAt)
+	data[override.ID] = override
+	if err := s.writeAudit(overrideActionCreate, override.CreatedBy, override.CreatedAt, override); err != nil {
+		return override, err
+	}
+	if err := s.save(data); err != nil {
+		return override, err
+	}
+	s.data = data
+	return override, nil
+}
+
+// Delete удаляет назначение по идентификатору. Если назначение не найдено, возвращается ErrNotFound.
+func (s *Override) Delete(id string, operator string, deletedAt time.Time) (model.ScheduleOverride, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	override, ok := s.data[id]
+	if !ok {
+		return override, ErrNotFound
+	}
+	data := s.actual(deletedAt)
+	delete(data, id)
+	if err := s.writeAudit(overrideActionDelete, operator, deletedAt, override); err != nil {
+		return override, err
+	}
+	if err := s.save(data); err != nil {
+		return override, err
+	}
+	s.data = data
+	return override, nil
+}
+
+// actual возвращает копию назначений без истекших к моменту currentTime.
+func (s *Override) actual(currentTime time.Time) map[string]model.ScheduleOverride {
+	data := make(map[string]model.ScheduleOverride, len(s.data))
+	for id, item := range s.data {
+		if !item.Schedule.To.Before(currentTime) {
+			data[id] = item
+		}
+	}
+	return data
+}
+
+// save записывает назначения во временный файл и заменяет им файл хранения.
+func (s *Override) save(data map[string]model.ScheduleOverride) error {
+	records := make([]overrideRecord, 0, len(data))
+	for _, item := range data {
+		records = append(records, newOverrideRecord(item))
+	}
+	slices.SortFunc(records, func(a, b overrideRecord) int {
+		return a.From.Compare(b.From)
+	})
+	b, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal overrides: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.file), 0o755); err != nil {
+		return fmt.Errorf("create overrides dir: %w", err)
+	}
+	tmp := s.file + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o644); err != nil {
+		return fmt.Errorf("write overrides: %w", err)
+	}
+	if err := os.Rename(tmp, s.file); err != nil {
+		return fmt.Errorf("replace overrides: %w", err)
+	}
+	return nil
+}
+
+func (s *Override) writeAudit(action, operator string, at time.Time, override model.ScheduleOverride) error {
+	if s.audit == nil {
+		return nil
+	}
+	b, err := json.Marshal(overrideAuditRecord{
+		Action:   action,
+		Operator: operator,
+		Time:     at,
+		Override: newOverrideRecord(override),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal override audit: %w", err)
+	}
+	if _, err := s.audit.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("write override audit: %w", err)
+	}
+	return nil
+}
+
+func (s *Override) readFromFile() (map[string]model.ScheduleOverride, error) {
+	b, err := os.ReadFile(s.file)
+	if errors.Is(err, os.ErrNotExist) {
+		return make(map[string]model.ScheduleOverride), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var records []overrideRecord
+	if err := json.Unmarshal(b, &records); err != nil {
+		return nil, err
+	}
+	data := make(map[string]model.ScheduleOverride, len(records))
+	for _, r := range records {
+		data[r.ID] = model.ScheduleOverride{
+			ID: r.ID,
+			Schedule: model.Schedule{
+				Number:      model.RouteNumber(r.Route),
+				StateNumber: model.StateNumber(r.StateNumber),
+				From:        r.From,
+				To:          r.To,
+			},
+			Reason:    r.Reason,
+			CreatedBy: r.CreatedBy,
+			CreatedAt: r.CreatedAt,
+		}
+	}
+	return data, nil
+}
+
+func newOverrideRecord(override model.ScheduleOverride) overrideRecord {
+	return overrideRecord{
+		ID:          override.ID,
+		Route:       override.Schedule.Number.String(),
+		StateNumber: override.Schedule.StateNumber.String(),
+		From:        override.Schedule.From,
+		To:          override.Schedule.To,
+		Reason:      override.Reason,
+		CreatedBy:   override.CreatedBy,
+		CreatedAt:   override.CreatedAt,
+	}
+}
@@ -0,0 +1,102 @@
+package repository_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+)
+
+func TestOverride(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "data", "overrides.json")
+	var audit bytes.Buffer
+	overrides, err := repository.NewOverride(file, &audit)
+	require.NoError(t, err)
+
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	create := func(route model.RouteNumber, from, to time.Duration, createdAt time.Duration) model.ScheduleOverride {
+		override, err := overrides.Create(model.ScheduleOverride{
+			Schedule: model.Schedule{
+				Number:      route,
+				StateNumber: "A001AA",
+				From:        now.Add(from),
+				To:          now.Add(to),
+			},
+			Reason:    "breakdown",
+			CreatedBy: "dispatcher",
+			CreatedAt: now.Add(createdAt),
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, override.ID)
+		return override
+	}
+	first := create("1", 0, 2*time.Hour, 0)
+	second := create("2", time.Hour, 3*time.Hour, time.Minute)
+
+	schedule, err := overrides.GetCurrent("A001AA", now.Add(30*time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, model.RouteNumber("1"), schedule.Number)
+	schedule, err = overrides.GetCurrent("A001AA", now.Add(90*time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, model.RouteNumber("2"), schedule.Number, "the latest override wins")
+	_, err = overrides.GetCurrent("B002BB", now.Add(90*time.Minute))
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	reloaded, err := repository.NewOverride(file, nil)
+	require.NoError(t, err)
+	require.Len(t, reloaded.List(now), 2, "overrides survive restart")
+
+	_, err = overrides.Delete(second.ID, "supervisor", now.Add(2*time.Minute))
+	require.NoError(t, err)
+	_, err = overrides.Delete(second.ID, "supervisor", now.Add(2*time.Minute))
+	require.ErrorIs(t, err, repository.ErrNotFound)
+	items := overrides.List(now)
+	require.Len(t, items, 1)
+	require.Equal(t, first.ID, items[0].ID)
+	require.Empty(t, overrides.List(now.Add(3*time.Hour)), "expired overrides are not listed")
+
+	var actions []string
+	for _, line := range bytes.Split(bytes.TrimSpace(audit.Bytes()), []byte("\n")) {
+		var record struct {
+			Action   string `json:"action"`
+			Operator string `json:"operator"`
+			Override struct {
+				ID string `json:"id"`
+			} `json:"override"`
+		}
+		require.NoError(t, json.Unmarshal(line, &record))
+		actions = append(actions, record.Action+" "+record.Operator+" "+record.Override.ID)
+	}
+	require.Equal(t, []string{
+		"create dispatcher " + first.ID,
+		"create dispatcher " + second.ID,
+		"delete supervisor " + second.ID,
+	}, actions)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestOverride_AuditFailure(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "overrides.json")
+	overrides, err := repository.NewOverride(file, failingWriter{})
+	require.NoError(t, err)
+
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	_, err = overrides.Create(model.ScheduleOverride{
+		Schedule:  model.Schedule{Number: "1", StateNumber: "A001AA", From: now, To: now.Add(time.Hour)},
+		CreatedBy: "dispatcher",
+		CreatedAt: now,
+	})
+	require.Error(t, err)
+	require.Empty(t, overrides.List(now), "change without audit record is not applied")
+	require.NoFileExists(t, file)
+}
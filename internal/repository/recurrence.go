@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dateLayout формат даты в справочниках повторяющегося расписания
+const dateLayout = "02/01/2006"
+
+// dailyWindow возвращает период действия, в который попадает t, для ежедневного интервала from-to по дням недели days.
+// Если to не больше from, интервал заканчивается на следующие сутки и относится к дню недели своего начала.
+func dailyWindow(t time.Time, days []time.Weekday, from, to time.Duration) (time.Time, time.Time, bool) {
+	midnight := startOfDay(t)
+	// интервал, начавшийся в текущие или предыдущие сутки
+	for _, day := range []time.Time{midnight, midnight.AddDate(0, 0, -1)} {
+		if len(days) > 0 && !slices.Contains(days, day.Weekday()) {
+			continue
+		}
+		start, end := dailyShift(day, from, to)
+		if !t.Before(start) && t.Before(end) {
+			return start, end, true
+		}
+	}
+	return time.Time{}, time.Time{}, false
+}
+
+// dailyShift возвращает начало и окончание интервала from-to, начинающегося в сутки day.
+// Если to не больше from, интервал заканчивается на следующие сутки.
+func dailyShift(day time.Time, from, to time.Duration) (time.Time, time.Time) {
+	length := to - from
+	if length <= 0 {
+		length += 24 * time.Hour
+	}
+	start := day.Add(from)
+	return start, start.Add(length)
+}
+
+// startOfDay возвращает начало суток t в часовом поясе t.
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// parseWeekdays парсит список дней недели вида `1-5,7`, где 1 - понедельник, 7 - воскресенье.
+func parseWeekdays(value string) ([]time.Weekday, error) {
+	var days []time.Weekday
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		first, last, isRange := strings.Cut(part, "-")
+		if !isRange {
+			last = first
+		}
+		from, err := strconv.Atoi(strings.TrimSpace(first))
+		if err != nil {
+			return nil, err
+		}
+		to, err := strconv.Atoi(strings.TrimSpace(last))
+		if err != nil {
+			return nil, err
+		}
+		if from < 1 || to > 7 || from > to {
+			return nil, fmt.Errorf("invalid days range `%s`", part)
+		}
+		for d := from; d <= to; d++ {
+			day := time.Weekday(d % 7)
+			if !slices.Contains(days, day) {
+				days = append(days, day)
+			}
+		}
+	}
+	return days, nil
+}
+
+// parseTimeOfDay парсит время суток вида `HH:MM`, допускается `24:00`.
+func parseTimeOfDay(value string) (time.Duration, error) {
+	hours, minutes, ok := strings.Cut(strings.TrimSpace(value), ":")
+	if !ok {
+		return 0, fmt.Errorf("time `%s` must be in the format `HH:MM`", value)
+	}
+	h, err := strconv.Atoi(hours)
+	if err != nil {
+		return 0, err
+	}
+	m, err := strconv.Atoi(minutes)
+	if err != nil {
+		return 0, err
+	}
+	if h < 0 || m < 0 || m > 59 || h*60+m > 24*60 {
+		return 0, fmt.Errorf("invalid time `%s`", value)
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
+
+// parseHours парсит интервал времени суток вида `22:00-06:00`; пустое значение означает круглосуточно.
+func parseHours(value string) (time.Duration, time.Duration, error) {
+	if strings.TrimSpace(value) == "" {
+		return 0, 24 * time.Hour, nil
+	}
+	from, to, ok := strings.Cut(value, "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("hours `%s` must be in the format `HH:MM-HH:MM`", value)
+	}
+	fromTime, err := parseTimeOfDay(from)
+	if err != nil {
+		return 0, 0, fmt.Errorf("parse from: %w", err)
+	}
+	toTime, err := parseTimeOfDay(to)
+	if err != nil {
+		return 0, 0, fmt.Errorf("parse to: %w", err)
+	}
+	return fromTime, toTime, nil
+}
+
+// parseDate парсит дату в формате dateLayout в часовом поясе location; пустое значение возвращает нулевое время.
+func parseDate(value string, location *time.Location) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.ParseInLocation(dateLayout, value, location)
+}
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/yaacov/observer/observer"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/pkg/geo"
+	"github.com/bars43ru/bus2map/pkg/xslog"
+)
+
+const (
+	extGeoJSON = ".geojson"
+	extGPX     = ".gpx"
+)
+
+// RouteShape справочник геометрии маршрутов. Геометрия каждого маршрута хранится в отдельном файле
+// каталога dir, имя файла без расширения - номер маршрута в расписании: `102Э.geojson`, `1.gpx`.
+// Файл GeoJSON должен содержать объект с геометрией LineString или MultiLineString,
+// файл GPX - трек (trk) или маршрут (rte).
+type RouteShape struct {
+	dir  string
+	data SafeMapAtomic[model.RouteNumber, model.RouteShape]
+}
+
+func NewRouteShape(dir string) *RouteShape {
+	return &RouteShape{
+		dir:  dir,
+		data: NewSafeMapAtomic[model.RouteNumber, model.RouteShape](),
+	}
+}
+
+func (s *RouteShape) Get(number model.RouteNumber) (model.RouteShape, error) {
+	r, ok := s.data.Get(number)
+	if !ok {
+		return r, ErrNotFound
+	}
+	return r, nil
+}
+
+func (s *RouteShape) Run(ctx context.Context) error {
+	o := observer.Observer{}
+	err := o.Watch([]string{
+		filepath.Join(s.dir, "*"+extGeoJSON),
+		filepath.Join(s.dir, "*"+extGPX),
+	})
+	if err != nil {
+		return fmt.Errorf("subscribe watch %s: %w", s.dir, err)
+	}
+	defer func(o *observer.Observer) {
+		err := o.Close()
+		if err != nil {
+			slog.ErrorContext(ctx, "close file change watch", xslog.Error(err))
+		}
+	}(&o)
+
+	replaceDatasource := func() {
+		shapes, err := s.readFromDir(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "load datasource route shape", xslog.Error(err))
+			return
+		}
+		s.replace(shapes)
+	}
+
+	o.AddListener(func(e interface{}) {
+		slog.InfoContext(ctx, fmt.Sprintf("file modified: %v", e))
+		replaceDatasource()
+	})
+	replaceDatasource()
+	<-ctx.Done()
+	return nil
+}
+
+func (s *RouteShape) replace(shapes []model.RouteShape) {
+	data := make(map[model.RouteNumber]model.RouteShape, len(shapes))
+	for _, shape := range shapes {
+		data[shape.Number] = shape
+	}
+	s.data.Replace(data)
+}
+
+// readFromDir загружает все файлы каталога; файл с ошибкой пропускается, чтобы не терять остальные маршруты.
+func (s *RouteShape) readFromDir(ctx context.Context) ([]model.RouteShape, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	var shapes []model.RouteShape
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || (ext != extGeoJSON && ext != extGPX) {
+			continue
+		}
+		file := filepath.Join(s.dir, entry.Name())
+		points, err := s.readFile(file, ext)
+		if err != nil {
+			slog.ErrorContext(ctx, "load route shape", slog.String("file", file), xslog.Error(err))
+			continue
+		}
+		if len(points) < 2 {
+			slog.ErrorContext(ctx, "route shape must contain at least 2 points", slog.String("file", file))
+			continue
+		}
+		shapes = append(shapes, model.RouteShape{
+			Number: model.RouteNumber(strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))),
+			Line:   geo.NewPolyline(points),
+		})
+	}
+	return shapes, nil
+}
+
+func (s *RouteShape) readFile(file string, ext string) ([]geo.Point, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	if ext == extGPX {
+		return s.parseGPX(b)
+	}
+	return s.parseGeoJSON(b)
+}
+
+func (s *RouteShape) parseGeoJSON(b []byte) ([]geo.Point, error) {
+	var header struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(b, &header); err != nil {
+		return nil, fmt.Errorf("unmarshal geojson: %w", err)
+	}
+	var features []geo.Feature
+	switch header.Type {
+	case "FeatureCollection":
+		var collection geo.FeatureCollection
+		if err := json.Unmarshal(b, &collection); err != nil {
+			return nil, fmt.Errorf("unmarshal geojson: %w", err)
+		}
+		features = collection.Features
+	case "Feature":
+		var feature geo.Feature
+		if err := json.Unmarshal(b, &feature); err != nil {
+			return nil, fmt.Errorf("unmarshal geojson: %w", err)
+		}
+		features = append(features, feature)
+	default:
+		return nil, fmt.Errorf("unexpected geojson type `%s`, want FeatureCollection or Feature", header.Type)
+	}
+	for _, feature := range features {
+		if feature.Geometry.Type == "LineString" || feature.Geometry.Type == "MultiLineString" {
+			return feature.Geometry.LineString()
+		}
+	}
+	return nil, fmt.Errorf("geojson doesn't contain LineString or MultiLineString")
+}
+
+type gpxPoint struct {
+	Lat float64 `xml:"lat,attr"`
+	Lon float64 `xml:"lon,attr"`
+}
+
+type gpx struct {
+	Tracks []struct {
+		Segments []struct {
+			Points []gpxPoint `xml:"trkpt"`
+		} `xml:"trkseg"`
+	} `xml:"trk"`
+	Routes []struct {
+		Points []gpxPoint `xml:"rtept"`
+	} `xml:"rte"`
+}
+
+func (s *RouteShape) parseGPX(b []byte) ([]geo.Point, error) {
+	var doc gpx
+	if err := xml.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal gpx: %w", err)
+	}
+	var raw []gpxPoint
+	for _, track := range doc.Tracks {
+		for _, segment := range track.Segments {
+			raw = append(raw, segment.Points...)
+		}
+	}
+	if len(raw) == 0 {
+		for _, route := range doc.Routes {
+			raw = append(raw, route.Points...)
+		}
+	}
+	points := make([]geo.Point, 0, len(raw))
+	for _, p := range raw {
+		points = append(points, geo.Point{Latitude: p.Lat, Longitude: p.Lon})
+	}
+	return points, nil
+}
@@ -0,0 +1,48 @@
+package repository_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/repository"
+)
+
+func TestRouteShape_Load(t *testing.T) {
+	dir := t.TempDir()
+	geojson := `{"type":"Feature","properties":{},"geometry":{"type":"MultiLineString",
+		"coordinates":[[[49.61,58.60],[49.62,58.60]],[[49.62,58.60],[49.63,58.605]]]}}`
+	gpx := `<?xml version="1.0"?><gpx version="1.1"><trk><trkseg>
+		<trkpt lat="58.60" lon="49.61"></trkpt><trkpt lat="58.61" lon="49.61"></trkpt>
+		</trkseg></trk></gpx>`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "102Э.geojson"), []byte(geojson), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "1.gpx"), []byte(gpx), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.geojson"), []byte(`{`), 0o644))
+
+	shapes := repository.NewRouteShape(dir)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- shapes.Run(ctx) }()
+	require.Eventually(t, func() bool {
+		_, err := shapes.Get("1")
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+
+	shape, err := shapes.Get("102Э")
+	require.NoError(t, err)
+	require.Len(t, shape.Line.Points, 4)
+	require.InDelta(t, 1382, shape.Line.Length(), 5)
+
+	shape, err = shapes.Get("1")
+	require.NoError(t, err)
+	require.InDelta(t, 1112, shape.Line.Length(), 5)
+
+	_, err = shapes.Get("broken")
+	require.ErrorIs(t, err, repository.ErrNotFound)
+}
@@ -14,6 +14,15 @@ func (s *SafeMapAtomic[Key, Value]) Get(key Key) (Value, bool) {
 	return value, exists
 }
 
+// Range вызывает fn для каждого элемента, пока fn возвращает true.
+func (s *SafeMapAtomic[Key, Value]) Range(fn func(key Key, value Value) bool) {
+	for key, value := range s.data.Load().(map[Key]Value) {
+		if !fn(key, value) {
+			return
+		}
+	}
+}
+
 func (s *SafeMapAtomic[Key, Value]) Replace(data map[Key]Value) {
 	s.data.Store(data)
 }
@@ -19,34 +19,105 @@ import (
 
 const patternSchedule = `(?P<route>[^;]*);(?P<transport>[^;]*);(?P<begin>[^;]+);(?P<end>[^;]+)`
 
+// Schedule расписание работы транспорта на маршрутах. Записи с абсолютными датой и временем начала и окончания
+// имеют приоритет над сменами, формируемыми из повторяющегося расписания templates.
 type Schedule struct {
-	file  string
-	regex *regexp.Regexp
-	data  SafeMapAtomic[model.StateNumber, []model.Schedule]
+	file      string
+	regex     *regexp.Regexp
+	data      SafeMapAtomic[model.StateNumber, []model.Schedule]
+	templates *ScheduleTemplate // nil, если повторяющееся расписание не используется
 }
 
-func NewSchedule(file string) *Schedule {
+func NewSchedule(file string, templates *ScheduleTemplate) *Schedule {
 	return &Schedule{
-		file:  file,
-		regex: regexp.MustCompile(patternSchedule),
-		data:  NewSafeMapAtomic[model.StateNumber, []model.Schedule](),
+		file:      file,
+		regex:     regexp.MustCompile(patternSchedule),
+		data:      NewSafeMapAtomic[model.StateNumber, []model.Schedule](),
+		templates: templates,
 	}
 }
 
 func (s *Schedule) GetCurrent(stateNumber model.StateNumber, currentTime time.Time) (model.Schedule, error) {
-	items, ok := s.data.Get(stateNumber)
-	if !ok {
-		return model.Schedule{}, ErrNotFound
-	}
+	items, _ := s.data.Get(stateNumber)
 	for _, item := range items {
 		if item.From.Compare(currentTime) <= 0 &&
 			item.To.Compare(currentTime) >= 0 {
 			return item, nil
 		}
 	}
+	if s.templates != nil {
+		if items := s.templates.Get(stateNumber, currentTime, 0, 0); len(items) > 0 {
+			return items[0], nil
+		}
+	}
+	return model.Schedule{}, ErrNotFound
+}
+
+// GetNearest возвращает запись расписания, действующую в момент currentTime с учетом допуска preStart до
+// начала и postEnd после окончания. Из нескольких подходящих записей выбирается ближайшая по времени.
+// Смены из повторяющегося расписания используются, только если не подошла ни одна запись с абсолютным временем.
+func (s *Schedule) GetNearest(
+	stateNumber model.StateNumber,
+	currentTime time.Time,
+	preStart, postEnd time.Duration,
+) (model.Schedule, error) {
+	items, _ := s.data.Get(stateNumber)
+	if item, ok := nearestSchedule(items, currentTime, preStart, postEnd); ok {
+		return item, nil
+	}
+	if s.templates != nil {
+		items := s.templates.Get(stateNumber, currentTime, preStart, postEnd)
+		if item, ok := nearestSchedule(items, currentTime, preStart, postEnd); ok {
+			return item, nil
+		}
+	}
 	return model.Schedule{}, ErrNotFound
 }
 
+// nearestSchedule выбирает из items ближайшую к currentTime запись, действующую с учетом допусков.
+func nearestSchedule(
+	items []model.Schedule,
+	currentTime time.Time,
+	preStart, postEnd time.Duration,
+) (model.Schedule, bool) {
+	var (
+		nearest  model.Schedule
+		distance time.Duration = -1
+	)
+	for _, item := range items {
+		if item.From.Add(-preStart).After(currentTime) || item.To.Add(postEnd).Before(currentTime) {
+			continue
+		}
+		d := max(item.From.Sub(currentTime), currentTime.Sub(item.To), 0)
+		if distance < 0 || d < distance {
+			nearest, distance = item, d
+		}
+	}
+	return nearest, distance >= 0
+}
+
+// ListActive возвращает записи расписания всех транспортных средств, действующие в момент currentTime.
+func (s *Schedule) ListActive(currentTime time.Time) []model.Schedule {
+	var result []model.Schedule
+	s.data.Range(func(_ model.StateNumber, items []model.Schedule) bool {
+		for _, item := range items {
+			if item.From.Compare(currentTime) <= 0 &&
+				item.To.Compare(currentTime) >= 0 {
+				result = append(result, item)
+			}
+		}
+		return true
+	})
+	if s.templates != nil {
+		for _, item := range s.templates.ListActive(currentTime) {
+			if !slices.ContainsFunc(result, func(v model.Schedule) bool { return v.StateNumber == item.StateNumber }) {
+				result = append(result, item)
+			}
+		}
+	}
+	return result
+}
+
 func (s *Schedule) Replace(schedules []model.Schedule) {
 	data := make(map[model.StateNumber][]model.Schedule, len(schedules))
 	for _, schedule := range schedules {
@@ -169,6 +240,11 @@ func (s *Schedule) parseRawRecord(record string) (model.Schedule, error) {
 }
 
 func (s *Schedule) ParseDateTime(value string) (time.Time, error) {
+	return parseDateTime(value)
+}
+
+// parseDateTime парсит дату и время в формате справочников расписания
+func parseDateTime(value string) (time.Time, error) {
 	const dateTimeFormat = "02/01/2006T15:04:05ZZ07:00"
 	t, err := time.Parse(dateTimeFormat, value)
 	if err != nil {
@@ -0,0 +1,322 @@
+package repository
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yaacov/observer/observer"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/pkg/xslog"
+)
+
+const (
+	patternScheduleTemplate = `(?P<route>[^;]*);(?P<transport>[^;]+);(?P<days>[^;]*);(?P<hours>[^;]*);(?P<valid_from>[^;]*);(?P<valid_to>[^;]*)`
+	patternCalendar         = `(?P<date>[^;]+);(?P<weekday>[^;]*)`
+)
+
+// ScheduleTemplate повторяющееся расписание и производственный календарь. Смены формируются из шаблонов
+// по запросу для нужных суток, поэтому файл не требуется пересоздавать каждый день.
+//
+// Каждая строка файла шаблонов: `номер маршрута;госномер;дни недели;часы;первый день;последний день`.
+// Дни недели задаются номерами от 1 (понедельник) до 7 (воскресенье) и диапазонами через запятую, например `1-5`,
+// часы - интервалом `22:00-06:00`; смена, переходящая через полночь, относится к дню недели своего начала.
+// Даты указываются в формате `02/01/2006`, пустые значения дней, часов и дат снимают ограничение.
+//
+// Каждая строка календаря: `дата;день недели`. Если день недели пустой или 0, смены в этот день не начинаются
+// (праздник), иначе в этот день действует расписание указанного дня недели (перенос рабочего дня).
+// Календарь необязателен: при отсутствии файла исключений нет.
+// Время шаблонов и даты календаря указываются в часовом поясе location.
+type ScheduleTemplate struct {
+	file          string
+	calendarFile  string
+	location      *time.Location
+	regex         *regexp.Regexp
+	calendarRegex *regexp.Regexp
+	data          SafeMapAtomic[model.StateNumber, []model.ScheduleTemplate]
+	calendar      SafeMapAtomic[string, model.CalendarException]
+}
+
+func NewScheduleTemplate(file, calendarFile string, location *time.Location) *ScheduleTemplate {
+	return &ScheduleTemplate{
+		file:          file,
+		calendarFile:  calendarFile,
+		location:      location,
+		regex:         regexp.MustCompile(patternScheduleTemplate),
+		calendarRegex: regexp.MustCompile(patternCalendar),
+		data:          NewSafeMapAtomic[model.StateNumber, []model.ScheduleTemplate](),
+		calendar:      NewSafeMapAtomic[string, model.CalendarException](),
+	}
+}
+
+// Get возвращает смены транспорта по шаблонам, в которые попадает currentTime с учетом допуска preStart
+// до начала и postEnd после окончания смены, упорядоченные по началу смены.
+func (s *ScheduleTemplate) Get(
+	stateNumber model.StateNumber,
+	currentTime time.Time,
+	preStart, postEnd time.Duration,
+) []model.Schedule {
+	items, ok := s.data.Get(stateNumber)
+	if !ok {
+		return nil
+	}
+	return s.expand(items, currentTime, preStart, postEnd)
+}
+
+// ListActive возвращает смены всех транспортных средств по шаблонам, действующие в момент currentTime.
+func (s *ScheduleTemplate) ListActive(currentTime time.Time) []model.Schedule {
+	var result []model.Schedule
+	s.data.Range(func(_ model.StateNumber, items []model.ScheduleTemplate) bool {
+		result = append(result, s.expand(items, currentTime, 0, 0)...)
+		return true
+	})
+	return result
+}
+
+func (s *ScheduleTemplate) expand(
+	items []model.ScheduleTemplate,
+	currentTime time.Time,
+	preStart, postEnd time.Duration,
+) []model.Schedule {
+	currentTime = currentTime.In(s.location)
+	// смена начинается в течение суток и длится не более суток, поэтому достаточно проверить двое предыдущих суток
+	first := startOfDay(currentTime.Add(-postEnd).AddDate(0, 0, -2))
+	last := startOfDay(currentTime.Add(preStart))
+	var result []model.Schedule
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		weekday, ok := s.weekday(day)
+		if !ok {
+			continue
+		}
+		for _, item := range items {
+			if len(item.Days) > 0 && !slices.Contains(item.Days, weekday) {
+				continue
+			}
+			if !item.ValidFrom.IsZero() && day.Before(item.ValidFrom) ||
+				!item.ValidTo.IsZero() && day.After(item.ValidTo) {
+				continue
+			}
+			from, to := dailyShift(day, item.From, item.To)
+			if from.Add(-preStart).After(currentTime) || to.Add(postEnd).Before(currentTime) {
+				continue
+			}
+			result = append(result, model.Schedule{
+				Number:      item.Number,
+				StateNumber: item.StateNumber,
+				From:        from,
+				To:          to,
+			})
+		}
+	}
+	slices.SortStableFunc(result, func(a, b model.Schedule) int {
+		return a.From.Compare(b.From)
+	})
+	return result
+}
+
+// weekday возвращает день недели, по расписанию которого работают в сутки day, с учетом календаря.
+func (s *ScheduleTemplate) weekday(day time.Time) (time.Weekday, bool) {
+	exception, ok := s.calendar.Get(day.Format(dateLayout))
+	if !ok {
+		return day.Weekday(), true
+	}
+	return exception.Weekday, exception.Service
+}
+
+func (s *ScheduleTemplate) Run(ctx context.Context) error {
+	o := observer.Observer{}
+	err := o.Watch([]string{s.file, s.calendarFile})
+	if err != nil {
+		return fmt.Errorf("subscribe watch %s, %s: %w", s.file, s.calendarFile, err)
+	}
+	defer func(o *observer.Observer) {
+		err := o.Close()
+		if err != nil {
+			slog.ErrorContext(ctx, "close file change watch", xslog.Error(err))
+		}
+	}(&o)
+
+	replaceDatasource := func() {
+		templates, err := s.readFromFile(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "load datasource schedule template", xslog.Error(err))
+			return
+		}
+		calendar, err := s.readCalendarFromFile(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "load datasource calendar", xslog.Error(err))
+			return
+		}
+		s.replace(templates, calendar)
+	}
+
+	o.AddListener(func(e interface{}) {
+		slog.InfoContext(ctx, fmt.Sprintf("file modified: %v", e))
+		replaceDatasource()
+	})
+	replaceDatasource()
+	<-ctx.Done()
+	return nil
+}
+
+func (s *ScheduleTemplate) replace(templates []model.ScheduleTemplate, calendar []model.CalendarException) {
+	data := make(map[model.StateNumber][]model.ScheduleTemplate)
+	for _, template := range templates {
+		data[template.StateNumber] = append(data[template.StateNumber], template)
+	}
+	exceptions := make(map[string]model.CalendarException, len(calendar))
+	for _, exception := range calendar {
+		exceptions[exception.Date.Format(dateLayout)] = exception
+	}
+	s.calendar.Replace(exceptions)
+	s.data.Replace(data)
+}
+
+func (s *ScheduleTemplate) readFromFile(ctx context.Context) ([]model.ScheduleTemplate, error) {
+	var templates []model.ScheduleTemplate
+	err := s.scan(ctx, s.file, func(record string) error {
+		template, err := s.parseRawRecord(record)
+		if err != nil {
+			return err
+		}
+		templates = append(templates, template)
+		return nil
+	})
+	return templates, err
+}
+
+func (s *ScheduleTemplate) readCalendarFromFile(ctx context.Context) ([]model.CalendarException, error) {
+	var calendar []model.CalendarException
+	err := s.scan(ctx, s.calendarFile, func(record string) error {
+		exception, err := s.parseCalendarRecord(record)
+		if err != nil {
+			return err
+		}
+		calendar = append(calendar, exception)
+		return nil
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	return calendar, err
+}
+
+func (s *ScheduleTemplate) scan(ctx context.Context, name string, parse func(record string) error) error {
+	file, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer func(*os.File) {
+		if err := file.Close(); err != nil {
+			slog.ErrorContext(ctx, "close file",
+				slog.String("file", name),
+				xslog.Error(err),
+			)
+		}
+	}(file)
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		record := scanner.Text()
+		if strings.TrimSpace(record) == "" {
+			continue
+		}
+		if err := parse(record); err != nil {
+			return fmt.Errorf("parse raw record `%s`: %w", record, err)
+		}
+	}
+	return scanner.Err()
+}
+
+// parseRawRecord парсит строку в шаблон расписания
+func (s *ScheduleTemplate) parseRawRecord(record string) (model.ScheduleTemplate, error) {
+	match := s.regex.FindStringSubmatch(record)
+	if match == nil {
+		return model.ScheduleTemplate{}, fmt.Errorf(
+			"raw record `%s` doesn't match the format `%s`", record, patternScheduleTemplate)
+	}
+
+	groupNames := s.regex.SubexpNames()
+	result := model.ScheduleTemplate{}
+
+	for i, name := range groupNames {
+		if i != 0 {
+			var err error
+			switch name {
+			case "route":
+				result.Number = model.RouteNumber(match[i])
+			case "transport":
+				result.StateNumber = model.StateNumber(match[i])
+			case "days":
+				if result.Days, err = parseWeekdays(match[i]); err != nil {
+					return result, fmt.Errorf("parse days: %w", err)
+				}
+			case "hours":
+				if result.From, result.To, err = parseHours(match[i]); err != nil {
+					return result, fmt.Errorf("parse hours: %w", err)
+				}
+			case "valid_from":
+				if result.ValidFrom, err = parseDate(match[i], s.location); err != nil {
+					return result, fmt.Errorf("parse valid from: %w", err)
+				}
+			case "valid_to":
+				if result.ValidTo, err = parseDate(match[i], s.location); err != nil {
+					return result, fmt.Errorf("parse valid to: %w", err)
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// parseCalendarRecord парсит строку в исключение календаря
+func (s *ScheduleTemplate) parseCalendarRecord(record string) (model.CalendarException, error) {
+	match := s.calendarRegex.FindStringSubmatch(record)
+	if match == nil {
+		return model.CalendarException{}, fmt.Errorf(
+			"raw record `%s` doesn't match the format `%s`", record, patternCalendar)
+	}
+
+	groupNames := s.calendarRegex.SubexpNames()
+	result := model.CalendarException{}
+
+	for i, name := range groupNames {
+		if i != 0 {
+			switch name {
+			case "date":
+				date, err := parseDate(match[i], s.location)
+				if err != nil {
+					return result, fmt.Errorf("parse date: %w", err)
+				}
+				if date.IsZero() {
+					return result, fmt.Errorf("date is required")
+				}
+				result.Date = date
+			case "weekday":
+				value := strings.TrimSpace(match[i])
+				if value == "" || value == "0" {
+					continue
+				}
+				weekday, err := strconv.Atoi(value)
+				if err != nil {
+					return result, fmt.Errorf("parse weekday: %w", err)
+				}
+				if weekday < 1 || weekday > 7 {
+					return result, fmt.Errorf("invalid weekday %d", weekday)
+				}
+				result.Service = true
+				result.Weekday = time.Weekday(weekday % 7)
+			}
+		}
+	}
+	return result, nil
+}
@@ -1,6 +1,9 @@
 package repository_test
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -52,7 +55,7 @@ func TestSchedule_ParseDateTime(t *testing.T) {
 }
 
 func TestSchedule_GetCurrent(t *testing.T) {
-	schedule := repository.NewSchedule("")
+	schedule := repository.NewSchedule("", nil)
 	schedule.Replace([]model.Schedule{
 		{
 			"4",
@@ -114,3 +117,102 @@ func TestSchedule_GetCurrent(t *testing.T) {
 		},
 	)
 }
+
+func TestSchedule_GetCurrentTemplates(t *testing.T) {
+	dir := t.TempDir()
+	templateFile := filepath.Join(dir, "schedule_template.txt")
+	calendarFile := filepath.Join(dir, "calendar.txt")
+	templates := "1;A001AA;1-5;06:00-14:00;;\n" +
+		"N1;A001AA;5,6;22:00-04:00;;\n" +
+		"2;B002BB;;08:00-20:00;06/01/2025;10/01/2025\n"
+	// 01/01/2025 - среда, праздник; 04/01/2025 - суббота, рабочий день по расписанию понедельника
+	calendar := "01/01/2025;\n04/01/2025;1\n"
+	require.NoError(t, os.WriteFile(templateFile, []byte(templates), 0o644))
+	require.NoError(t, os.WriteFile(calendarFile, []byte(calendar), 0o644))
+
+	location := time.FixedZone("UTC+3", 3*60*60)
+	template := repository.NewScheduleTemplate(templateFile, calendarFile, location)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- template.Run(ctx) }()
+	require.Eventually(t, func() bool {
+		return len(template.Get("A001AA", time.Date(2025, 1, 2, 10, 0, 0, 0, location), 0, 0)) > 0
+	}, time.Second, 10*time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+
+	schedule := repository.NewSchedule("", template)
+	schedule.Replace([]model.Schedule{
+		{
+			Number:      "override",
+			StateNumber: "A001AA",
+			From:        time.Date(2025, 1, 2, 12, 0, 0, 0, location),
+			To:          time.Date(2025, 1, 2, 13, 0, 0, 0, location),
+		},
+	})
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, 1, day, hour, minute, 0, 0, location)
+	}
+	tests := []struct {
+		name        string
+		stateNumber model.StateNumber
+		at          time.Time
+		number      model.RouteNumber
+		from, to    time.Time
+	}{
+		{name: "holiday", stateNumber: "A001AA", at: at(1, 10, 0)},
+		{name: "weekday", stateNumber: "A001AA", at: at(2, 10, 0), number: "1", from: at(2, 6, 0), to: at(2, 14, 0)},
+		{name: "absolute entry has priority", stateNumber: "A001AA", at: at(2, 12, 30), number: "override",
+			from: at(2, 12, 0), to: at(2, 13, 0)},
+		{name: "night before midnight", stateNumber: "A001AA", at: at(3, 23, 0), number: "N1",
+			from: at(3, 22, 0), to: at(4, 4, 0)},
+		{name: "night after midnight", stateNumber: "A001AA", at: at(4, 3, 0), number: "N1",
+			from: at(3, 22, 0), to: at(4, 4, 0)},
+		{name: "moved working day", stateNumber: "A001AA", at: at(4, 7, 0), number: "1", from: at(4, 6, 0), to: at(4, 14, 0)},
+		{name: "moved working day replaces weekday", stateNumber: "A001AA", at: at(4, 23, 0)},
+		{name: "before validity", stateNumber: "B002BB", at: at(5, 10, 0)},
+		{name: "in validity", stateNumber: "B002BB", at: at(10, 19, 0), number: "2", from: at(10, 8, 0), to: at(10, 20, 0)},
+		{name: "after validity", stateNumber: "B002BB", at: at(11, 10, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := schedule.GetCurrent(tt.stateNumber, tt.at.UTC())
+			if tt.number == "" {
+				require.ErrorIs(t, err, repository.ErrNotFound)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.number, v.Number)
+			require.True(t, tt.from.Equal(v.From), "from %v", v.From)
+			require.True(t, tt.to.Equal(v.To), "to %v", v.To)
+		})
+	}
+
+	active := schedule.ListActive(at(2, 12, 30))
+	require.Len(t, active, 1)
+	require.Equal(t, model.RouteNumber("override"), active[0].Number)
+
+	v, err := schedule.GetNearest("A001AA", at(2, 5, 45), 30*time.Minute, 0)
+	require.NoError(t, err)
+	require.Equal(t, model.RouteNumber("1"), v.Number)
+
+	v, err = schedule.GetNearest("A001AA", at(2, 11, 45), 30*time.Minute, 0)
+	require.NoError(t, err)
+	require.Equal(t, model.RouteNumber("override"), v.Number, "absolute entry within tolerance has priority over template")
+}
+
+func TestScheduleTemplate_WithoutCalendar(t *testing.T) {
+	dir := t.TempDir()
+	templateFile := filepath.Join(dir, "schedule_template.txt")
+	require.NoError(t, os.WriteFile(templateFile, []byte("1;A001AA;;06:00-14:00;;\n"), 0o644))
+
+	template := repository.NewScheduleTemplate(templateFile, filepath.Join(dir, "calendar.txt"), time.UTC)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- template.Run(ctx) }()
+	require.Eventually(t, func() bool {
+		return len(template.Get("A001AA", time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC), 0, 0)) > 0
+	}, time.Second, 10*time.Millisecond, "templates are loaded without calendar")
+	cancel()
+	require.NoError(t, <-done)
+}
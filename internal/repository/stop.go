@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/yaacov/observer/observer"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/pkg/xslog"
+)
+
+const patternStop = `(?P<route>[^;]*);(?P<sequence>\d+);(?P<id>[^;]+);(?P<name>[^;]*);(?P<latitude>[^;]+);(?P<longitude>[^;]+)`
+
+// Stop справочник остановок маршрутов. Каждая строка описывает остановку на маршруте:
+// `номер маршрута;порядковый номер;идентификатор остановки;название;широта;долгота`.
+// Остановка, через которую проходят несколько маршрутов, указывается для каждого из них.
+type Stop struct {
+	file  string
+	regex *regexp.Regexp
+	data  SafeMapAtomic[model.RouteNumber, model.RouteStops]
+}
+
+func NewStop(file string) *Stop {
+	return &Stop{
+		file:  file,
+		regex: regexp.MustCompile(patternStop),
+		data:  NewSafeMapAtomic[model.RouteNumber, model.RouteStops](),
+	}
+}
+
+// GetRouteStops возвращает остановки маршрута в порядке следования.
+func (s *Stop) GetRouteStops(number model.RouteNumber) (model.RouteStops, error) {
+	r, ok := s.data.Get(number)
+	if !ok {
+		return r, ErrNotFound
+	}
+	return r, nil
+}
+
+func (s *Stop) Run(ctx context.Context) error {
+	o := observer.Observer{}
+	err := o.Watch([]string{s.file})
+	if err != nil {
+		return fmt.Errorf("subscribe watch %s: %w", s.file, err)
+	}
+	defer func(o *observer.Observer) {
+		err := o.Close()
+		if err != nil {
+			slog.ErrorContext(ctx, "close file change watch", xslog.Error(err))
+		}
+	}(&o)
+
+	replaceDatasource := func() {
+		stops, err := s.readFromFile(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "load datasource stop", xslog.Error(err))
+			return
+		}
+		s.replace(stops)
+	}
+
+	o.AddListener(func(e interface{}) {
+		slog.InfoContext(ctx, fmt.Sprintf("file modified: %v", e))
+		replaceDatasource()
+	})
+	replaceDatasource()
+	<-ctx.Done()
+	return nil
+}
+
+func (s *Stop) replace(stops map[model.RouteNumber][]model.RouteStop) {
+	data := make(map[model.RouteNumber]model.RouteStops, len(stops))
+	for number, items := range stops {
+		slices.SortStableFunc(items, func(a, b model.RouteStop) int {
+			return int(a.Sequence) - int(b.Sequence)
+		})
+		data[number] = model.RouteStops{Number: number, Stops: items}
+	}
+	s.data.Replace(data)
+}
+
+func (s *Stop) readFromFile(ctx context.Context) (map[model.RouteNumber][]model.RouteStop, error) {
+	file, err := os.Open(s.file)
+	if err != nil {
+		return nil, err
+	}
+	defer func(*os.File) {
+		if err := file.Close(); err != nil {
+			slog.ErrorContext(ctx, "close file",
+				slog.String("file", s.file),
+				xslog.Error(err),
+			)
+		}
+	}(file)
+
+	stops := make(map[model.RouteNumber][]model.RouteStop)
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		record := scanner.Text()
+		if strings.TrimSpace(record) == "" {
+			continue
+		}
+
+		number, stop, err := s.parseRawRecord(record)
+		if err != nil {
+			return nil, fmt.Errorf("parse raw record `%s`: %w", record, err)
+		}
+
+		stops[number] = append(stops[number], stop)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return stops, nil
+}
+
+// parseRawRecord парсит строку в номер маршрута и остановку на нем
+func (s *Stop) parseRawRecord(record string) (model.RouteNumber, model.RouteStop, error) {
+	match := s.regex.FindStringSubmatch(record)
+	if match == nil {
+		return "", model.RouteStop{}, fmt.Errorf("raw record `%s` doesn't match the format `%s`", record, patternStop)
+	}
+
+	groupNames := s.regex.SubexpNames()
+	var number model.RouteNumber
+	result := model.RouteStop{}
+
+	for i, name := range groupNames {
+		if i != 0 {
+			switch name {
+			case "route":
+				number = model.RouteNumber(match[i])
+			case "sequence":
+				sequence, err := strconv.ParseUint(match[i], 10, 32)
+				if err != nil {
+					return "", result, fmt.Errorf("parse sequence: %w", err)
+				}
+				result.Sequence = uint32(sequence)
+			case "id":
+				result.ID = model.StopID(match[i])
+			case "name":
+				result.Name = match[i]
+			case "latitude":
+				latitude, err := strconv.ParseFloat(match[i], 64)
+				if err != nil {
+					return "", result, fmt.Errorf("parse latitude: %w", err)
+				}
+				result.Location.Latitude = latitude
+			case "longitude":
+				longitude, err := strconv.ParseFloat(match[i], 64)
+				if err != nil {
+					return "", result, fmt.Errorf("parse longitude: %w", err)
+				}
+				result.Location.Longitude = longitude
+			}
+		}
+	}
+	if !result.Location.Valid() {
+		return "", result, fmt.Errorf("invalid coordinates %v", result.Location)
+	}
+	return number, result, nil
+}
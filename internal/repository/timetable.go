@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/yaacov/observer/observer"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/pkg/xslog"
+)
+
+const patternTimetable = `(?P<trip>[^;]+);(?P<route>[^;]*);(?P<transport>[^;]*);(?P<sequence>\d+);(?P<stop>[^;]+);(?P<departure>[^;]+)`
+
+// Timetable расписание движения по остановкам. Каждая строка описывает плановое отправление рейса от остановки:
+// `рейс;номер маршрута;госномер;порядковый номер остановки;идентификатор остановки;время отправления`.
+// Госномер указывается, если рейс закреплен за транспортным средством, иначе остается пустым.
+type Timetable struct {
+	file  string
+	regex *regexp.Regexp
+	data  SafeMapAtomic[model.RouteNumber, []model.Trip]
+}
+
+func NewTimetable(file string) *Timetable {
+	return &Timetable{
+		file:  file,
+		regex: regexp.MustCompile(patternTimetable),
+		data:  NewSafeMapAtomic[model.RouteNumber, []model.Trip](),
+	}
+}
+
+// GetTrips возвращает рейсы маршрута, упорядоченные по времени отправления от первой остановки.
+func (s *Timetable) GetTrips(number model.RouteNumber) ([]model.Trip, error) {
+	trips, ok := s.data.Get(number)
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return trips, nil
+}
+
+func (s *Timetable) Run(ctx context.Context) error {
+	o := observer.Observer{}
+	err := o.Watch([]string{s.file})
+	if err != nil {
+		return fmt.Errorf("subscribe watch %s: %w", s.file, err)
+	}
+	defer func(o *observer.Observer) {
+		err := o.Close()
+		if err != nil {
+			slog.ErrorContext(ctx, "close file change watch", xslog.Error(err))
+		}
+	}(&o)
+
+	replaceDatasource := func() {
+		trips, err := s.readFromFile(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "load datasource timetable", xslog.Error(err))
+			return
+		}
+		s.replace(trips)
+	}
+
+	o.AddListener(func(e interface{}) {
+		slog.InfoContext(ctx, fmt.Sprintf("file modified: %v", e))
+		replaceDatasource()
+	})
+	replaceDatasource()
+	<-ctx.Done()
+	return nil
+}
+
+func (s *Timetable) replace(trips map[string]*model.Trip) {
+	data := make(map[model.RouteNumber][]model.Trip)
+	for _, trip := range trips {
+		slices.SortStableFunc(trip.Stops, func(a, b model.TripStop) int {
+			return int(a.Sequence) - int(b.Sequence)
+		})
+		data[trip.RouteNumber] = append(data[trip.RouteNumber], *trip)
+	}
+	for _, v := range data {
+		slices.SortStableFunc(v, func(a, b model.Trip) int {
+			return a.Stops[0].Departure.Compare(b.Stops[0].Departure)
+		})
+	}
+	s.data.Replace(data)
+}
+
+func (s *Timetable) readFromFile(ctx context.Context) (map[string]*model.Trip, error) {
+	file, err := os.Open(s.file)
+	if err != nil {
+		return nil, err
+	}
+	defer func(*os.File) {
+		if err := file.Close(); err != nil {
+			slog.ErrorContext(ctx, "close file",
+				slog.String("file", s.file),
+				xslog.Error(err),
+			)
+		}
+	}(file)
+
+	trips := make(map[string]*model.Trip)
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		record := scanner.Text()
+		if strings.TrimSpace(record) == "" {
+			continue
+		}
+
+		row, stop, err := s.parseRawRecord(record)
+		if err != nil {
+			return nil, fmt.Errorf("parse raw record `%s`: %w", record, err)
+		}
+
+		trip, ok := trips[row.ID]
+		if !ok {
+			trip = &row
+			trips[row.ID] = trip
+		}
+		if trip.RouteNumber != row.RouteNumber || trip.StateNumber != row.StateNumber {
+			return nil, fmt.Errorf("trip `%s` has different route or transport in record `%s`", row.ID, record)
+		}
+		trip.Stops = append(trip.Stops, stop)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return trips, nil
+}
+
+// parseRawRecord парсит строку в рейс без остановок и плановое отправление от остановки
+func (s *Timetable) parseRawRecord(record string) (model.Trip, model.TripStop, error) {
+	match := s.regex.FindStringSubmatch(record)
+	if match == nil {
+		return model.Trip{}, model.TripStop{}, fmt.Errorf(
+			"raw record `%s` doesn't match the format `%s`", record, patternTimetable)
+	}
+
+	groupNames := s.regex.SubexpNames()
+	trip := model.Trip{}
+	stop := model.TripStop{}
+
+	for i, name := range groupNames {
+		if i != 0 {
+			switch name {
+			case "trip":
+				trip.ID = match[i]
+			case "route":
+				trip.RouteNumber = model.RouteNumber(match[i])
+			case "transport":
+				trip.StateNumber = model.StateNumber(match[i])
+			case "sequence":
+				sequence, err := strconv.ParseUint(match[i], 10, 32)
+				if err != nil {
+					return trip, stop, fmt.Errorf("parse sequence: %w", err)
+				}
+				stop.Sequence = uint32(sequence)
+			case "stop":
+				stop.StopID = model.StopID(match[i])
+			case "departure":
+				t, err := parseDateTime(match[i])
+				if err != nil {
+					return trip, stop, fmt.Errorf("invalid departure datetime: %w", err)
+				}
+				stop.Departure = t
+			}
+		}
+	}
+	return trip, stop, nil
+}
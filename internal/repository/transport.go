@@ -2,12 +2,16 @@ package repository
 
 import (
 	"bufio"
+	"cmp"
 	"context"
 	"fmt"
 	"log/slog"
 	"os"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/yaacov/observer/observer"
 
@@ -16,13 +20,20 @@ import (
 	"github.com/bars43ru/bus2map/pkg/xslog"
 )
 
-// Регулярное выражение для парсинга строк: uid;state;type
-const patternTransport = `(?P<uid>[^;]*);(?P<state>[^;]*);(?P<type>[^;]*)`
+// Регулярное выражение для парсинга строк: uid;state;type;priority;valid_from;valid_to.
+// Приоритет необязателен, по умолчанию 0. Если на транспорте установлено несколько трекеров,
+// меньшее значение приоритета означает основной трекер.
+// Период действия привязки трекера к транспорту необязателен и задается в формате справочника расписания,
+// окончание не входит в период; пустые значения снимают ограничение.
+const patternTransport = `(?P<uid>[^;]*);(?P<state>[^;]*);(?P<type>[^;]*)` +
+	`(?:;(?P<priority>[^;]*))?(?:;(?P<valid_from>[^;]*))?(?:;(?P<valid_to>[^;]*))?`
 
+// Transport привязки трекеров к транспорту. Трекер может последовательно обслуживать несколько
+// транспортных средств, периоды действия его привязок не должны пересекаться.
 type Transport struct {
 	file  string
 	regex *regexp.Regexp
-	data  SafeMapAtomic[string, model.Transport]
+	data  SafeMapAtomic[string, []model.Transport]
 }
 
 type transportGUID struct {
@@ -33,16 +44,46 @@ func NewTransport(file string) *Transport {
 	return &Transport{
 		file:  file,
 		regex: regexp.MustCompile(patternTransport),
-		data:  NewSafeMapAtomic[string, model.Transport](),
+		data:  NewSafeMapAtomic[string, []model.Transport](),
 	}
 }
 
-func (s *Transport) Get(uuid string) (model.Transport, error) {
-	t, ok := s.data.Get(uuid)
-	if !ok {
-		return t, ErrNotFound
+// Get возвращает транспорт, который обслуживал трекер uuid в момент at.
+func (s *Transport) Get(uuid string, at time.Time) (model.Transport, error) {
+	items, _ := s.data.Get(uuid)
+	for _, t := range items {
+		if bindingActive(t, at) {
+			return t, nil
+		}
 	}
-	return t, nil
+	return model.Transport{}, ErrNotFound
+}
+
+// bindingActive возвращает true, если привязка трекера к транспорту действует в момент at.
+func bindingActive(t model.Transport, at time.Time) bool {
+	return (t.ValidFrom.IsZero() || !at.Before(t.ValidFrom)) && (t.ValidTo.IsZero() || at.Before(t.ValidTo))
+}
+
+// History возвращает привязки трекеров к транспорту, упорядоченные по госномеру и началу действия.
+// Если uid или stateNumber заданы, возвращаются только привязки трекера или транспорта.
+func (s *Transport) History(uid string, stateNumber model.StateNumber) []model.Transport {
+	var result []model.Transport
+	s.data.Range(func(_ string, items []model.Transport) bool {
+		for _, t := range items {
+			if (uid == "" || t.GUID == uid) && (stateNumber == "" || t.StateNumber == stateNumber) {
+				result = append(result, t)
+			}
+		}
+		return true
+	})
+	slices.SortFunc(result, func(a, b model.Transport) int {
+		return cmp.Or(
+			strings.Compare(a.StateNumber.String(), b.StateNumber.String()),
+			a.ValidFrom.Compare(b.ValidFrom),
+			strings.Compare(a.GUID, b.GUID),
+		)
+	})
+	return result
 }
 
 func (s *Transport) Run(ctx context.Context) error {
@@ -64,7 +105,7 @@ func (s *Transport) Run(ctx context.Context) error {
 			slog.ErrorContext(ctx, "load datasource transport", xslog.Error(err))
 			return
 		}
-		s.replace(transports)
+		s.replace(ctx, transports)
 	}
 
 	o.AddListener(func(e interface{}) {
@@ -76,14 +117,38 @@ func (s *Transport) Run(ctx context.Context) error {
 	return nil
 }
 
-func (s *Transport) replace(transports []model.Transport) {
-	data := make(map[string]model.Transport, len(transports))
-	for _, t := range transports {
-		data[t.GUID] = t
+// replace заменяет привязки трекеров. Привязка, пересекающаяся с указанной в файле позднее привязкой того же
+// трекера, не загружается, поэтому при повторе UID действует последняя запись, как и без периодов действия.
+func (s *Transport) replace(ctx context.Context, transports []model.Transport) {
+	data := make(map[string][]model.Transport, len(transports))
+	for _, t := range slices.Backward(transports) {
+		items := data[t.GUID]
+		if i := slices.IndexFunc(items, func(item model.Transport) bool {
+			return bindingsOverlap(item, t)
+		}); i != -1 {
+			slog.ErrorContext(ctx, "skip overlapping transport binding",
+				slog.String("uid", t.GUID),
+				slog.String("state_number", t.StateNumber.String()),
+				slog.String("overlaps", items[i].StateNumber.String()),
+			)
+			continue
+		}
+		data[t.GUID] = append(items, t)
+	}
+	for _, items := range data {
+		slices.SortFunc(items, func(a, b model.Transport) int {
+			return a.ValidFrom.Compare(b.ValidFrom)
+		})
 	}
 	s.data.Replace(data)
 }
 
+// bindingsOverlap возвращает true, если периоды действия привязок пересекаются.
+func bindingsOverlap(a, b model.Transport) bool {
+	return (a.ValidTo.IsZero() || b.ValidFrom.IsZero() || b.ValidFrom.Before(a.ValidTo)) &&
+		(b.ValidTo.IsZero() || a.ValidFrom.IsZero() || a.ValidFrom.Before(b.ValidTo))
+}
+
 func (s *Transport) readFromFile(ctx context.Context) ([]model.Transport, error) {
 	file, err := os.Open(s.file)
 	if err != nil {
@@ -145,6 +210,28 @@ func (s *Transport) parseRawRecord(record string) (model.Transport, error) {
 					return model.Transport{}, fmt.Errorf("unexpected value `%s` for `transport_type`: %w", match[i], err)
 				}
 				result.Type = _type
+			case "priority":
+				if strings.TrimSpace(match[i]) == "" {
+					continue
+				}
+				priority, err := strconv.Atoi(strings.TrimSpace(match[i]))
+				if err != nil {
+					return model.Transport{}, fmt.Errorf("unexpected value `%s` for `priority`: %w", match[i], err)
+				}
+				result.Priority = priority
+			case "valid_from", "valid_to":
+				if strings.TrimSpace(match[i]) == "" {
+					continue
+				}
+				t, err := parseDateTime(strings.TrimSpace(match[i]))
+				if err != nil {
+					return model.Transport{}, fmt.Errorf("parse %s: %w", name, err)
+				}
+				if name == "valid_from" {
+					result.ValidFrom = t
+				} else {
+					result.ValidTo = t
+				}
 			}
 		}
 	}
@@ -0,0 +1,113 @@
+package repository_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+)
+
+func TestTransport_Get(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "transport.txt")
+	data := "dev1;A001AA;bus;0;;01/03/2025T10:00:00Z+03:00\n" +
+		"dev1;B002BB;bus;1;01/03/2025T10:00:00Z+03:00;\n" +
+		"dev2;A001AA;bus;;01/03/2025T12:00:00Z+03:00;\n" +
+		"dev3;C003CC;tramway\n"
+	require.NoError(t, os.WriteFile(file, []byte(data), 0o644))
+
+	transport := repository.NewTransport(file)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- transport.Run(ctx) }()
+	require.Eventually(t, func() bool {
+		_, err := transport.Get("dev3", time.Now())
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+
+	location := time.FixedZone("UTC+3", 3*60*60)
+	at := func(hour int) time.Time {
+		return time.Date(2025, 3, 1, hour, 0, 0, 0, location)
+	}
+	tests := []struct {
+		uid         string
+		at          time.Time
+		stateNumber model.StateNumber
+	}{
+		{uid: "dev1", at: at(9), stateNumber: "A001AA"},
+		{uid: "dev1", at: at(10), stateNumber: "B002BB"},
+		{uid: "dev1", at: at(23), stateNumber: "B002BB"},
+		{uid: "dev2", at: at(11)},
+		{uid: "dev2", at: at(12), stateNumber: "A001AA"},
+		{uid: "dev3", at: at(0), stateNumber: "C003CC"},
+		{uid: "dev4", at: at(0)},
+	}
+	for _, tt := range tests {
+		v, err := transport.Get(tt.uid, tt.at)
+		if tt.stateNumber == "" {
+			require.ErrorIs(t, err, repository.ErrNotFound, "%s at %v", tt.uid, tt.at)
+			continue
+		}
+		require.NoError(t, err, "%s at %v", tt.uid, tt.at)
+		require.Equal(t, tt.stateNumber, v.StateNumber, "%s at %v", tt.uid, tt.at)
+	}
+
+	history := transport.History("", "A001AA")
+	require.Len(t, history, 2)
+	require.Equal(t, "dev1", history[0].GUID)
+	require.True(t, history[0].ValidTo.Equal(at(10)))
+	require.Equal(t, "dev2", history[1].GUID)
+	require.True(t, history[1].ValidFrom.Equal(at(12)))
+
+	history = transport.History("dev1", "")
+	require.Len(t, history, 2)
+	require.Equal(t, model.StateNumber("A001AA"), history[0].StateNumber)
+	require.Equal(t, 1, history[1].Priority)
+	require.Len(t, transport.History("", ""), 4)
+}
+
+func TestTransport_GetOverlapping(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "transport.txt")
+	data := "dev1;A001AA;bus\n" +
+		"dev1;B002BB;bus\n" +
+		"dev2;C003CC;bus;;01/03/2025T10:00:00Z+03:00;01/03/2025T14:00:00Z+03:00\n" +
+		"dev2;D004DD;bus;;01/03/2025T12:00:00Z+03:00;\n" +
+		"dev2;E005EE;bus;;;01/03/2025T10:00:00Z+03:00\n" +
+		"dev3;F006FF;tramway\n"
+	require.NoError(t, os.WriteFile(file, []byte(data), 0o644))
+
+	transport := repository.NewTransport(file)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- transport.Run(ctx) }()
+	require.Eventually(t, func() bool {
+		_, err := transport.Get("dev3", time.Now())
+		return err == nil
+	}, time.Second, 10*time.Millisecond, "overlapping bindings don't reject the file")
+	cancel()
+	require.NoError(t, <-done)
+
+	location := time.FixedZone("UTC+3", 3*60*60)
+	at := func(hour int) time.Time {
+		return time.Date(2025, 3, 1, hour, 0, 0, 0, location)
+	}
+	v, err := transport.Get("dev1", at(0))
+	require.NoError(t, err)
+	require.Equal(t, model.StateNumber("B002BB"), v.StateNumber, "the last duplicate UID is used")
+
+	v, err = transport.Get("dev2", at(9))
+	require.NoError(t, err)
+	require.Equal(t, model.StateNumber("E005EE"), v.StateNumber)
+	_, err = transport.Get("dev2", at(11))
+	require.ErrorIs(t, err, repository.ErrNotFound, "the overlapping binding is skipped")
+	v, err = transport.Get("dev2", at(13))
+	require.NoError(t, err)
+	require.Equal(t, model.StateNumber("D004DD"), v.StateNumber)
+}
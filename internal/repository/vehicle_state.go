@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bars43ru/bus2map/internal/model"
+)
+
+// VehicleState хранит в памяти последнее известное состояние каждого транспортного средства.
+type VehicleState struct {
+	mu       sync.RWMutex
+	data     map[model.StateNumber]model.VehicleState
+	byUID    map[string]model.StateNumber
+	lastSeen map[model.StateNumber]time.Time
+}
+
+func NewVehicleState() *VehicleState {
+	return &VehicleState{
+		data:     make(map[model.StateNumber]model.VehicleState),
+		byUID:    make(map[string]model.StateNumber),
+		lastSeen: make(map[model.StateNumber]time.Time),
+	}
+}
+
+// Update сохраняет состояние, если оно не старше уже сохраненного по времени GPS, и возвращает true,
+// если состояние сохранено.
+func (s *VehicleState) Update(info model.BusTrackingInfo, updatedAt time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current, ok := s.data[info.Transport.StateNumber]
+	if ok && current.Info.Location.Time.After(info.Location.Time) {
+		return false
+	}
+	s.data[info.Transport.StateNumber] = model.VehicleState{
+		Info:      info,
+		UpdatedAt: updatedAt,
+	}
+	s.byUID[info.Location.UID] = info.Transport.StateNumber
+	return true
+}
+
+// Seen отмечает получение данных от транспортного средства, в том числе не опубликованных.
+func (s *VehicleState) Seen(stateNumber model.StateNumber, seenAt time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if seenAt.After(s.lastSeen[stateNumber]) {
+		s.lastSeen[stateNumber] = seenAt
+	}
+}
+
+// LastSeen возвращает время последнего получения данных от транспортного средства.
+func (s *VehicleState) LastSeen(stateNumber model.StateNumber) (time.Time, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	t, ok := s.lastSeen[stateNumber]
+	return t, ok
+}
+
+func (s *VehicleState) Get(stateNumber model.StateNumber) (model.VehicleState, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.data[stateNumber]
+	if !ok {
+		return v, ErrNotFound
+	}
+	return v, nil
+}
+
+func (s *VehicleState) GetByUID(uid string) (model.VehicleState, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	stateNumber, ok := s.byUID[uid]
+	if !ok {
+		return model.VehicleState{}, ErrNotFound
+	}
+	v, ok := s.data[stateNumber]
+	if !ok {
+		return v, ErrNotFound
+	}
+	return v, nil
+}
+
+func (s *VehicleState) List() []model.VehicleState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	items := make([]model.VehicleState, 0, len(s.data))
+	for _, v := range s.data {
+		items = append(items, v)
+	}
+	return items
+}
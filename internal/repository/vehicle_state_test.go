@@ -0,0 +1,30 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+)
+
+func TestVehicleState_Update(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	state := repository.NewVehicleState()
+	info := func(seconds int, lat float64) model.BusTrackingInfo {
+		return model.BusTrackingInfo{
+			Transport: model.Transport{StateNumber: "A001AA"},
+			Location:  model.GPS{UID: "1", Time: start.Add(time.Duration(seconds) * time.Second), Latitude: lat},
+		}
+	}
+
+	require.True(t, state.Update(info(10, 58.61), start))
+	require.False(t, state.Update(info(0, 58.60), start), "older point is ignored")
+	v, err := state.Get("A001AA")
+	require.NoError(t, err)
+	require.Equal(t, 58.61, v.Info.Location.Latitude)
+	require.True(t, state.Update(info(10, 58.62), start), "point with the same time replaces the state")
+	require.True(t, state.Update(info(20, 58.63), start))
+}
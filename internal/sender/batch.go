@@ -0,0 +1,139 @@
+package sender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/imkira/go-observer/v2"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/pkg/xslog"
+)
+
+// Batch параметры накопления пакета данных и повторной отправки.
+type Batch struct {
+	Size        int           // максимальное количество точек в пакете
+	Interval    time.Duration // максимальное время накопления пакета
+	SendTimeout time.Duration // таймаут одной попытки отправки
+	Attempts    int           // количество попыток отправки пакета
+	RetryDelay  time.Duration // задержка перед первой повторной попыткой, далее удваивается
+}
+
+// DefaultBatch параметры, с которыми отправляются данные в агрегаторы.
+// Количество попыток задается для каждого получателя в его настройках.
+var DefaultBatch = Batch{
+	Size:        50,
+	Interval:    5 * time.Second,
+	SendTimeout: 30 * time.Second,
+	Attempts:    1,
+	RetryDelay:  time.Second,
+}
+
+// runBatches накапливает из потока пакеты данных, удовлетворяющих фильтру,
+// и передает их в send с повторными попытками в случае ошибки.
+func runBatches(
+	ctx context.Context,
+	cfg Batch,
+	observer observer.Stream[*model.BusTrackingInfo],
+	filter func(info *model.BusTrackingInfo) bool,
+	send func(ctx context.Context, items []model.BusTrackingInfo) error,
+) error {
+	for ctx.Err() == nil {
+		items := makeChunk(ctx, cfg, observer, filter)
+		if len(items) == 0 {
+			slog.InfoContext(ctx, "no data to send")
+			continue
+		}
+		slog.InfoContext(ctx, "data sending", slog.Int("size", len(items)))
+		if err := sendWithRetry(ctx, cfg, func(ctx context.Context) error { return send(ctx, items) }); err != nil {
+			slog.ErrorContext(ctx, "send data packet", xslog.Error(err))
+		}
+	}
+	return nil
+}
+
+func makeChunk(
+	ctx context.Context,
+	cfg Batch,
+	observer observer.Stream[*model.BusTrackingInfo],
+	filter func(info *model.BusTrackingInfo) bool,
+) []model.BusTrackingInfo {
+	ctx, cancel := context.WithTimeout(ctx, cfg.Interval)
+	defer cancel()
+	chunk := make([]model.BusTrackingInfo, 0, cfg.Size)
+	for {
+		select {
+		case <-observer.Changes():
+			busTrackingInfo := observer.Next()
+			if busTrackingInfo == nil {
+				continue
+			}
+			if filter != nil && !filter(busTrackingInfo) {
+				continue
+			}
+			chunk = append(chunk, *busTrackingInfo)
+			if len(chunk) >= cfg.Size {
+				slog.InfoContext(ctx, "data packet has been formed for sending")
+				return chunk
+			}
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "the data packet accumulation time has expired")
+			return chunk
+		}
+	}
+}
+
+// permanentError ошибка отправки, которая повторится при любой попытке, например ошибка формирования тела запроса.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// permanent помечает ошибку отправки как неисправимую повторной попыткой.
+func permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// sendWithRetry выполняет отправку, повторяя ее с экспоненциальной задержкой. Ошибки, помеченные permanent,
+// не повторяются. Отправка уже сформированного пакета не прерывается при остановке сервиса.
+func sendWithRetry(ctx context.Context, cfg Batch, send func(ctx context.Context) error) error {
+	attempts := max(cfg.Attempts, 1)
+	delay := cfg.RetryDelay
+	for attempt := 1; ; attempt++ {
+		err := func() error {
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cfg.SendTimeout)
+			defer cancel()
+			return send(ctx)
+		}()
+		if err == nil {
+			return nil
+		}
+		var permanentErr *permanentError
+		if errors.As(err, &permanentErr) {
+			return fmt.Errorf("send: %w", err)
+		}
+		if attempt == attempts || ctx.Err() != nil {
+			return fmt.Errorf("send (attempt %d of %d): %w", attempt, attempts, err)
+		}
+		slog.WarnContext(ctx, "send attempt failed",
+			slog.Int("attempt", attempt),
+			slog.Duration("retry_after", delay),
+			xslog.Error(err),
+		)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+		}
+		delay *= 2
+	}
+}
@@ -0,0 +1,42 @@
+package sender
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendWithRetry(t *testing.T) {
+	cfg := Batch{SendTimeout: time.Second, Attempts: 3, RetryDelay: time.Millisecond}
+	errSend := errors.New("send failed")
+
+	var calls int
+	err := sendWithRetry(context.Background(), cfg, func(context.Context) error {
+		calls++
+		if calls < 3 {
+			return errSend
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, calls, "transient error is retried")
+
+	calls = 0
+	err = sendWithRetry(context.Background(), cfg, func(context.Context) error {
+		calls++
+		return errSend
+	})
+	require.ErrorIs(t, err, errSend)
+	require.Equal(t, 3, calls)
+
+	calls = 0
+	err = sendWithRetry(context.Background(), cfg, func(context.Context) error {
+		calls++
+		return permanent(errSend)
+	})
+	require.ErrorIs(t, err, errSend)
+	require.Equal(t, 1, calls, "permanent error is not retried")
+}
@@ -0,0 +1,63 @@
+package sender
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/imkira/go-observer/v2"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/model/transport_type"
+	"github.com/bars43ru/bus2map/internal/protocols/webhook"
+)
+
+// Filter ограничивает данные, передаваемые получателю. Пустой список означает отсутствие ограничения.
+type Filter struct {
+	Routes         []model.RouteNumber
+	TransportTypes []transport_type.Type
+}
+
+func (f Filter) Match(info *model.BusTrackingInfo) bool {
+	if len(f.Routes) != 0 && !slices.Contains(f.Routes, info.Route.Number) {
+		return false
+	}
+	if len(f.TransportTypes) != 0 && !slices.Contains(f.TransportTypes, info.Transport.Type) {
+		return false
+	}
+	return true
+}
+
+// BridgeWebhook отправляет данные на произвольный HTTP-адрес в формате, заданном шаблоном.
+// В пакетном режиме шаблон получает []model.BusTrackingInfo, иначе отдельный model.BusTrackingInfo на каждую точку.
+// attempts задает количество попыток отправки, каждая ограничена DefaultBatch.SendTimeout.
+func BridgeWebhook(
+	cli *webhook.Client,
+	tmpl *webhook.Template,
+	batch bool,
+	attempts int,
+	filter Filter,
+	observer observer.Stream[*model.BusTrackingInfo],
+) func(ctx context.Context) error {
+	cfg := DefaultBatch
+	cfg.Attempts = attempts
+	if !batch {
+		cfg.Size = 1
+	}
+
+	send := func(ctx context.Context, items []model.BusTrackingInfo) error {
+		var data any = items
+		if !batch {
+			data = items[0]
+		}
+		body, err := tmpl.Execute(data)
+		if err != nil {
+			return permanent(fmt.Errorf("render webhook body: %w", err))
+		}
+		return cli.Send(ctx, body)
+	}
+
+	return func(ctx context.Context) error {
+		return runBatches(ctx, cfg, observer, filter.Match, send)
+	}
+}
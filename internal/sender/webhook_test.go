@@ -0,0 +1,144 @@
+package sender
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/imkira/go-observer/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/model/transport_type"
+	"github.com/bars43ru/bus2map/internal/protocols/webhook"
+)
+
+func TestFilter_Match(t *testing.T) {
+	info := func(route model.RouteNumber, typ transport_type.Type) *model.BusTrackingInfo {
+		return &model.BusTrackingInfo{
+			Transport: model.Transport{Type: typ},
+			Route:     model.Route{Number: route},
+		}
+	}
+	tests := []struct {
+		name   string
+		filter Filter
+		info   *model.BusTrackingInfo
+		want   bool
+	}{
+		{
+			name: "empty filter",
+			info: info("1", transport_type.TypeBUS),
+			want: true,
+		},
+		{
+			name:   "route matched",
+			filter: Filter{Routes: []model.RouteNumber{"1", "2"}},
+			info:   info("2", transport_type.TypeBUS),
+			want:   true,
+		},
+		{
+			name:   "route not matched",
+			filter: Filter{Routes: []model.RouteNumber{"1", "2"}},
+			info:   info("3", transport_type.TypeBUS),
+		},
+		{
+			name:   "transport type matched",
+			filter: Filter{TransportTypes: []transport_type.Type{transport_type.TypeTROLLEYBUS}},
+			info:   info("1", transport_type.TypeTROLLEYBUS),
+			want:   true,
+		},
+		{
+			name:   "transport type not matched",
+			filter: Filter{TransportTypes: []transport_type.Type{transport_type.TypeTROLLEYBUS}},
+			info:   info("1", transport_type.TypeBUS),
+		},
+		{
+			name: "route and transport type",
+			filter: Filter{
+				Routes:         []model.RouteNumber{"1"},
+				TransportTypes: []transport_type.Type{transport_type.TypeBUS},
+			},
+			info: info("1", transport_type.TypeTROLLEYBUS),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.filter.Match(tt.info))
+		})
+	}
+}
+
+func TestBridgeWebhook(t *testing.T) {
+	interval := DefaultBatch.Interval
+	DefaultBatch.Interval = 200 * time.Millisecond
+	t.Cleanup(func() { DefaultBatch.Interval = interval })
+
+	tests := []struct {
+		name  string
+		batch bool
+		text  string
+		want  []string
+	}{
+		{
+			name: "per point",
+			text: `{{.Transport.StateNumber}}`,
+			want: []string{"A001AA", "C003CC"},
+		},
+		{
+			name:  "per batch",
+			batch: true,
+			text:  `{{range .}}{{.Transport.StateNumber}};{{end}}`,
+			want:  []string{"A001AA;C003CC;"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bodies := make(chan string, 10)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				bodies <- string(body)
+			}))
+			defer srv.Close()
+
+			tmpl, err := webhook.ParseTemplate("test", tt.text)
+			require.NoError(t, err)
+			prop := observer.NewProperty[*model.BusTrackingInfo](nil)
+			filter := Filter{Routes: []model.RouteNumber{"1"}}
+			run := BridgeWebhook(webhook.New(srv.URL, "", nil, "", ""), tmpl, tt.batch, 1, filter, prop.Observe())
+
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				_ = run(ctx)
+			}()
+			for _, stateNumber := range []model.StateNumber{"A001AA", "B002BB", "C003CC"} {
+				route := model.RouteNumber("1")
+				if stateNumber == "B002BB" {
+					route = "2"
+				}
+				prop.Update(&model.BusTrackingInfo{
+					Transport: model.Transport{StateNumber: stateNumber},
+					Route:     model.Route{Number: route},
+				})
+			}
+
+			got := make([]string, 0, len(tt.want))
+			for range tt.want {
+				select {
+				case body := <-bodies:
+					got = append(got, body)
+				case <-time.After(5 * time.Second):
+					t.Fatal("webhook was not called")
+				}
+			}
+			cancel()
+			<-done
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
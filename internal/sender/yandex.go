@@ -2,15 +2,13 @@ package sender
 
 import (
 	"context"
-	"log/slog"
-	"time"
 
 	"github.com/imkira/go-observer/v2"
 
 	"github.com/bars43ru/bus2map/internal/model"
 	"github.com/bars43ru/bus2map/internal/model/transport_type"
 	"github.com/bars43ru/bus2map/internal/protocols/yandex"
-	"github.com/bars43ru/bus2map/pkg/xslog"
+	"github.com/bars43ru/bus2map/pkg/geo"
 )
 
 var _TransportTypeToVehicleType = map[transport_type.Type]yandex.VehicleType{
@@ -20,72 +18,49 @@ var _TransportTypeToVehicleType = map[transport_type.Type]yandex.VehicleType{
 	transport_type.TypeMINIBUS:    yandex.MinibusVehicleType,
 }
 
+// BridgeYandex отправляет данные в формате Яндекса. При snapped вместо координат трекера
+// передается положение, привязанное к линии маршрута, если привязка удалась.
+// attempts задает количество попыток отправки пакета, каждая ограничена DefaultBatch.SendTimeout.
 func BridgeYandex(
 	cliYandex yandex.Client,
+	snapped bool,
+	attempts int,
 	observer observer.Stream[*model.BusTrackingInfo],
 ) func(ctx context.Context) error {
-	const sizeChunk = 50
-	makeChunk := func(ctx context.Context) []model.BusTrackingInfo {
-		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
-		defer cancel()
-		chunk := make([]model.BusTrackingInfo, 0, sizeChunk)
-	L:
-		for {
-			select {
-			case <-observer.Changes():
-				busTrackingInfo := observer.Next()
-				if busTrackingInfo == nil {
-					continue
-				}
-				chunk = append(chunk, *busTrackingInfo)
-				if len(chunk) >= 50 {
-					slog.InfoContext(ctx, "data packet has been formed for sending")
-					break L
-				}
-			case <-ctx.Done():
-				slog.InfoContext(ctx, "the data packet accumulation time has expired")
-				break L
+	send := func(ctx context.Context, items []model.BusTrackingInfo) error {
+		tracks := make([]yandex.Track, 0, len(items))
+		for _, busTrackingInfo := range items {
+			point := position(busTrackingInfo, snapped)
+			track := yandex.Track{
+				UUID:        busTrackingInfo.Transport.StateNumber.String(),
+				Category:    yandex.NormalGpsSignal,
+				Route:       busTrackingInfo.Route.YandexNumber,
+				VehicleType: _TransportTypeToVehicleType[busTrackingInfo.Transport.Type],
+				Point: yandex.Point{
+					Latitude:  point.Latitude,
+					Longitude: point.Longitude,
+					AvgSpeed:  uint(busTrackingInfo.Location.Speed),
+					Direction: uint(busTrackingInfo.Location.Course),
+					Time:      yandex.CustomTime(busTrackingInfo.Location.Time),
+				},
 			}
+			tracks = append(tracks, track)
 		}
-		return chunk
-	}
-
-	send := func(ctx context.Context, tracks []yandex.Track) error {
-		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
-		defer cancel()
 		return cliYandex.Send(ctx, tracks)
 	}
 
+	cfg := DefaultBatch
+	cfg.Attempts = attempts
+
 	return func(ctx context.Context) error {
-		for ctx.Err() == nil {
-			busTrackingInfoItems := makeChunk(ctx)
-			if len(busTrackingInfoItems) == 0 {
-				slog.InfoContext(ctx, "no data to send")
-				continue
-			}
-			tracks := make([]yandex.Track, 0, len(busTrackingInfoItems))
-			for _, busTrackingInfo := range busTrackingInfoItems {
-				track := yandex.Track{
-					UUID:        busTrackingInfo.Transport.StateNumber.String(),
-					Category:    yandex.NormalGpsSignal,
-					Route:       busTrackingInfo.Route.YandexNumber,
-					VehicleType: _TransportTypeToVehicleType[busTrackingInfo.Transport.Type],
-					Point: yandex.Point{
-						Latitude:  busTrackingInfo.Location.Latitude,
-						Longitude: busTrackingInfo.Location.Longitude,
-						AvgSpeed:  uint(busTrackingInfo.Location.Speed),
-						Direction: uint(busTrackingInfo.Location.Course),
-						Time:      yandex.CustomTime(busTrackingInfo.Location.Time),
-					},
-				}
-				tracks = append(tracks, track)
-			}
+		return runBatches(ctx, cfg, observer, nil, send)
+	}
+}
 
-			slog.InfoContext(ctx, "data sending")
-			if err := send(ctx, tracks); err != nil {
-				slog.ErrorContext(ctx, "send tracks to yandex", xslog.Error(err))
-			}
-		}
-		return nil
+// position возвращает координаты для публикации: привязанные к маршруту, если это требуется и возможно.
+func position(info model.BusTrackingInfo, snapped bool) geo.Point {
+	if snapped && info.Match.Matched {
+		return info.Match.Position
 	}
+	return geo.Point{Latitude: info.Location.Latitude, Longitude: info.Location.Longitude}
 }
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+	"github.com/bars43ru/bus2map/pkg/geo"
+	"github.com/bars43ru/bus2map/pkg/xslog"
+)
+
+// AdherenceRules пороги соблюдения расписания. Движение считается своевременным,
+// если опережение не больше Early, а опоздание не больше Late.
+type AdherenceRules struct {
+	Early        time.Duration
+	Late         time.Duration
+	MaxDeviation time.Duration // рейс с большим отклонением не сопоставляется с транспортом
+}
+
+type adherenceState struct {
+	tripID   string
+	sequence uint32 // последняя остановка, по которой сопоставлялся рейс
+}
+
+// ScheduleAdherence вычисляет отклонение транспорта от расписания движения по остановкам.
+type ScheduleAdherence struct {
+	timetable *repository.Timetable
+	rules     AdherenceRules
+	mu        sync.Mutex
+	vehicles  map[model.StateNumber]adherenceState
+}
+
+func NewScheduleAdherence(timetable *repository.Timetable, rules AdherenceRules) *ScheduleAdherence {
+	return &ScheduleAdherence{
+		timetable: timetable,
+		rules:     rules,
+		vehicles:  make(map[model.StateNumber]adherenceState),
+	}
+}
+
+// Evaluate сравнивает положение транспорта в последовательности остановок с рейсом расписания.
+// Рейс выбирается по наименьшему отклонению и сохраняется за транспортом, пока тот движется
+// по остановкам рейса вперед. Ожидание на остановке до планового отправления не считается опережением.
+func (a *ScheduleAdherence) Evaluate(info model.BusTrackingInfo, progress model.TripProgress) model.Adherence {
+	stateNumber := info.Transport.StateNumber
+	trips, err := a.timetable.GetTrips(info.Route.Number)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			slog.Error("get timetable trips", slog.String("route_number", info.Route.Number.String()), xslog.Error(err))
+		}
+		return model.Adherence{}
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	// Рейс, который больше не подходит (например, изменилось расписание), выбирается заново.
+	result, ok := model.Adherence{}, false
+	if prev, sticky := a.vehicles[stateNumber]; sticky && progress.LastStop.Sequence >= prev.sequence {
+		result, ok = a.match(trips, prev.tripID, info, progress)
+	}
+	if !ok {
+		result, ok = a.match(trips, "", info, progress)
+	}
+	if !ok {
+		delete(a.vehicles, stateNumber)
+		return model.Adherence{}
+	}
+	a.vehicles[stateNumber] = adherenceState{tripID: result.TripID, sequence: progress.LastStop.Sequence}
+	result.Status = a.status(result.Deviation)
+	return result
+}
+
+// match выбирает рейс с наименьшим отклонением; если задан tripID, рассматривается только он
+// без ограничения на величину отклонения.
+func (a *ScheduleAdherence) match(
+	trips []model.Trip,
+	tripID string,
+	info model.BusTrackingInfo,
+	progress model.TripProgress,
+) (model.Adherence, bool) {
+	var (
+		best  model.Adherence
+		found bool
+	)
+	for _, trip := range trips {
+		if trip.StateNumber != "" && trip.StateNumber != info.Transport.StateNumber {
+			continue
+		}
+		if tripID != "" && trip.ID != tripID {
+			continue
+		}
+		deviation, ok := a.deviation(trip, info, progress)
+		if !ok || (tripID == "" && deviation.Abs() > a.rules.MaxDeviation) {
+			continue
+		}
+		if !found || deviation.Abs() < best.Deviation.Abs() {
+			best = model.Adherence{Known: true, TripID: trip.ID, Deviation: deviation}
+			found = true
+		}
+	}
+	return best, found
+}
+
+// deviation вычисляет отклонение от рейса в текущем положении транспорта. Между остановками плановое время
+// интерполируется пропорционально расстоянию до соседних остановок.
+func (a *ScheduleAdherence) deviation(
+	trip model.Trip,
+	info model.BusTrackingInfo,
+	progress model.TripProgress,
+) (time.Duration, bool) {
+	current := -1
+	for i, stop := range trip.Stops {
+		if stop.Sequence == progress.LastStop.Sequence {
+			current = i
+			break
+		}
+	}
+	if current < 0 {
+		return 0, false
+	}
+	planned := trip.Stops[current].Departure
+	actual := info.Location.Time
+	if progress.AtStop {
+		return max(actual.Sub(planned), 0), true
+	}
+	if current+1 == len(trip.Stops) {
+		return actual.Sub(planned), true
+	}
+	next := trip.Stops[current+1]
+	for _, prediction := range progress.Predictions {
+		if prediction.Stop.Sequence != next.Sequence {
+			continue
+		}
+		point := geo.Point{Latitude: info.Location.Latitude, Longitude: info.Location.Longitude}
+		passed := geo.Distance(progress.LastStop.Location, point)
+		remaining := geo.Distance(point, prediction.Stop.Location)
+		if passed+remaining > 0 {
+			planned = planned.Add(time.Duration(float64(next.Departure.Sub(planned)) * passed / (passed + remaining)))
+		}
+		break
+	}
+	return actual.Sub(planned), true
+}
+
+func (a *ScheduleAdherence) status(deviation time.Duration) model.AdherenceStatus {
+	switch {
+	case deviation < -a.rules.Early:
+		return model.AdherenceEarly
+	case deviation > a.rules.Late:
+		return model.AdherenceLate
+	}
+	return model.AdherenceOnTime
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+	"github.com/bars43ru/bus2map/pkg/geo"
+)
+
+func TestScheduleAdherence(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "timetable.txt")
+	data := "t1;1;;1;a;01/01/2025T12:00:00Z+00:00\n" +
+		"t1;1;;2;b;01/01/2025T12:10:00Z+00:00\n" +
+		"t2;1;;1;a;01/01/2025T12:30:00Z+00:00\n" +
+		"t2;1;;2;b;01/01/2025T12:40:00Z+00:00\n"
+	require.NoError(t, os.WriteFile(file, []byte(data), 0o644))
+	timetable := repository.NewTimetable(file)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() { _ = timetable.Run(ctx) }()
+	require.Eventually(t, func() bool {
+		_, err := timetable.GetTrips("1")
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+
+	a := NewScheduleAdherence(timetable, AdherenceRules{Early: time.Minute, Late: 3 * time.Minute, MaxDeviation: 15 * time.Minute})
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	stopA := model.RouteStop{Stop: model.Stop{ID: "a", Location: geo.Point{Latitude: 58.6, Longitude: 49.61}}, Sequence: 1}
+	stopB := model.RouteStop{Stop: model.Stop{ID: "b", Location: geo.Point{Latitude: 58.6, Longitude: 49.63}}, Sequence: 2}
+	evaluate := func(minutes float64, lon float64, atStop bool) model.Adherence {
+		info := model.BusTrackingInfo{
+			Route:     model.Route{Number: "1"},
+			Transport: model.Transport{StateNumber: "A001AA"},
+			Location: model.GPS{
+				Time:      start.Add(time.Duration(minutes * float64(time.Minute))),
+				Latitude:  58.6,
+				Longitude: lon,
+			},
+		}
+		progress := model.TripProgress{
+			LastStop:    stopA,
+			AtStop:      atStop,
+			Predictions: []model.StopPrediction{{Stop: stopB}},
+		}
+		return a.Evaluate(info, progress)
+	}
+
+	adherence := evaluate(-2, 49.61, true)
+	require.True(t, adherence.Known)
+	require.Equal(t, "t1", adherence.TripID)
+	require.Zero(t, adherence.Deviation, "waiting for the planned departure is not early")
+	require.Equal(t, model.AdherenceOnTime, adherence.Status)
+
+	// Половина пути между остановками через 9 минут при плане 5 минут
+	adherence = evaluate(9, 49.62, false)
+	require.Equal(t, "t1", adherence.TripID)
+	require.InDelta(t, 4*time.Minute, adherence.Deviation, float64(time.Second))
+	require.Equal(t, model.AdherenceLate, adherence.Status)
+
+	// Рейс сохраняется за транспортом, даже если отклонение ближе к следующему рейсу
+	adherence = evaluate(20, 49.625, false)
+	require.Equal(t, "t1", adherence.TripID)
+
+	adherence = evaluate(2, 49.62, false)
+	require.Equal(t, "t1", adherence.TripID)
+	require.InDelta(t, -3*time.Minute, adherence.Deviation, float64(time.Second))
+	require.Equal(t, model.AdherenceEarly, adherence.Status)
+}
@@ -3,7 +3,11 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/imkira/go-observer/v2"
 
@@ -14,73 +18,371 @@ import (
 
 type BusTracking struct {
 	location  observer.Property[*model.BusTrackingInfo]
+	alert     observer.Property[*model.Alert]
+	stopEvent observer.Property[*model.StopEvent]
 	route     *repository.Route
 	transport *repository.Transport
 	schedule  *repository.Schedule
+	state     *repository.VehicleState
+	diag      *repository.Diagnostics
+	alerts    *repository.Alerts
+	validator *Validator
+	smoother  *Smoother
+	geofence  *Geofencing
+	motion    *MotionEstimator
+	matcher   *MapMatcher
+	offRoute  *OffRouteDetector
+	stops     *StopTracker
+	adherence *ScheduleAdherence
+	headway   *HeadwayMonitor
+	missing   *MissingVehicleDetector
+	resolver  *ScheduleResolver
+	overrides *repository.Override
+	clock     *ClockSkewEstimator
+	merger    *TrackerMerger
+	uids      *UIDNamespaces
+	bindings  *trackerBindings
+	pipeline  *Pipeline
 }
 
-func New(
-	route *repository.Route,
-	transport *repository.Transport,
-	schedule *repository.Schedule,
-) *BusTracking {
-	return &BusTracking{
+// ErrOverridesDisabled назначения диспетчера отключены в конфигурации
+var ErrOverridesDisabled = errors.New("schedule overrides are disabled")
+
+// Options зависимости сервиса. Справочники Route, Transport, Schedule, State, Diagnostics и Alerts обязательны,
+// остальные функции отключены, если не заданы.
+type Options struct {
+	Route       *repository.Route
+	Transport   *repository.Transport
+	Schedule    *repository.Schedule
+	State       *repository.VehicleState
+	Diagnostics *repository.Diagnostics
+	Alerts      *repository.Alerts
+	Overrides   *repository.Override
+	Validator   *Validator
+	Smoother    *Smoother
+	Geofence    *Geofencing
+	Motion      *MotionEstimator
+	Matcher     *MapMatcher
+	OffRoute    *OffRouteDetector
+	Stops       *StopTracker
+	Adherence   *ScheduleAdherence
+	Headway     *HeadwayMonitor
+	Missing     *MissingVehicleDetector
+	Resolver    *ScheduleResolver
+	Clock       *ClockSkewEstimator
+	Merger      *TrackerMerger
+	UIDs        *UIDNamespaces
+}
+
+func New(opts Options) *BusTracking {
+	s := &BusTracking{
 		location:  observer.NewProperty[*model.BusTrackingInfo](nil),
-		route:     route,
-		transport: transport,
-		schedule:  schedule,
+		alert:     observer.NewProperty[*model.Alert](nil),
+		stopEvent: observer.NewProperty[*model.StopEvent](nil),
+		route:     opts.Route,
+		transport: opts.Transport,
+		schedule:  opts.Schedule,
+		state:     opts.State,
+		diag:      opts.Diagnostics,
+		alerts:    opts.Alerts,
+		validator: opts.Validator,
+		smoother:  opts.Smoother,
+		geofence:  opts.Geofence,
+		motion:    opts.Motion,
+		matcher:   opts.Matcher,
+		offRoute:  opts.OffRoute,
+		stops:     opts.Stops,
+		adherence: opts.Adherence,
+		headway:   opts.Headway,
+		missing:   opts.Missing,
+		resolver:  opts.Resolver,
+		overrides: opts.Overrides,
+		clock:     opts.Clock,
+		merger:    opts.Merger,
+		uids:      opts.UIDs,
+		bindings:  newTrackerBindings(),
 	}
+	// имена встроенных стадий уникальны, поэтому создание конвейера по умолчанию не завершается ошибкой
+	s.pipeline, _ = NewPipeline(s.BuiltinStages()...)
+	return s
 }
 
 func (s *BusTracking) SubscribeLocation() observer.Stream[*model.BusTrackingInfo] {
 	return s.location.Observe()
 }
 
-func (s *BusTracking) ProcessGPSData(ctx context.Context, gpsData model.GPS) {
-	transport, err := s.transport.Get(gpsData.UID)
-	if err != nil {
-		l := slog.With(slog.String("uid", gpsData.UID))
-		if errors.Is(err, repository.ErrNotFound) {
-			l.WarnContext(ctx, "not found UID in transport")
-			// TODO тут записываем что есть какой то UID сигнала по которому нет данных о привязке к транспорту
-			return
+// SubscribeAlerts возвращает поток изменений состояния событий для диспетчера.
+func (s *BusTracking) SubscribeAlerts() observer.Stream[*model.Alert] {
+	return s.alert.Observe()
+}
+
+// SubscribeStopEvents возвращает поток событий прибытия на остановки и отправления с них.
+func (s *BusTracking) SubscribeStopEvents() observer.Stream[*model.StopEvent] {
+	return s.stopEvent.Observe()
+}
+
+// Trips возвращает положение в последовательности остановок и прогноз прибытия транспортных средств,
+// данные от которых получены не ранее since. Если отслеживание остановок отключено, возвращается nil.
+func (s *BusTracking) Trips(since time.Time) []model.TripProgress {
+	if s.stops == nil {
+		return nil
+	}
+	trips := s.stops.Trips()
+	return slices.DeleteFunc(trips, func(trip model.TripProgress) bool {
+		v, err := s.state.Get(trip.StateNumber)
+		return err != nil || v.UpdatedAt.Before(since)
+	})
+}
+
+// Headways возвращает интервалы движения по маршрутам; если route задан, только по нему.
+// Если контроль интервалов отключен, возвращается nil.
+func (s *BusTracking) Headways(route model.RouteNumber) []model.RouteHeadway {
+	if s.headway == nil {
+		return nil
+	}
+	return s.headway.Headways(route, time.Now())
+}
+
+// MissingVehicles возвращает транспорт, который должен работать по расписанию, но не передает данные.
+// Если выявление отсутствующего транспорта отключено, возвращается nil.
+func (s *BusTracking) MissingVehicles() []model.MissingVehicle {
+	if s.missing == nil {
+		return nil
+	}
+	return s.missing.List()
+}
+
+// WatchMissingVehicles периодически проверяет выход транспорта на связь по расписанию и публикует события.
+func (s *BusTracking) WatchMissingVehicles(ctx context.Context) error {
+	if s.missing == nil {
+		return nil
+	}
+	ticker := time.NewTicker(s.missing.Interval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			for _, alert := range s.missing.Check(now) {
+				s.publishAlert(ctx, alert)
+			}
 		}
-		l.ErrorContext(ctx, "get transport from UID", xslog.Error(err))
-		return
 	}
-	schedule, err := s.schedule.GetCurrent(transport.StateNumber, gpsData.Time)
-	if err != nil {
-		l := slog.With(
-			slog.String("state_number", transport.StateNumber.String()),
-			slog.Time("gps_time", gpsData.Time),
-		)
-		if errors.Is(err, repository.ErrNotFound) {
-			l.WarnContext(ctx, "not found schedule for transport")
-			// TODO тут записываем что есть какой то UID сигнала по которому нет данных о привязке к транспорту
-			return
+}
+
+// staleAlertsInterval периодичность завершения событий по транспорту, от которого нет данных
+const staleAlertsInterval = 30 * time.Second
+
+// WatchStaleAlerts периодически завершает события по транспорту, от которого нет данных.
+func (s *BusTracking) WatchStaleAlerts(ctx context.Context) error {
+	if s.offRoute == nil && s.headway == nil {
+		return nil
+	}
+	ticker := time.NewTicker(staleAlertsInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			var alerts []model.Alert
+			if s.offRoute != nil {
+				alerts = append(alerts, s.offRoute.Sweep(now)...)
+			}
+			if s.headway != nil {
+				alerts = append(alerts, s.headway.Sweep(now)...)
+			}
+			for _, alert := range alerts {
+				s.publishAlert(ctx, alert)
+			}
 		}
-		l.ErrorContext(ctx, "get schedule for transport", xslog.Error(err))
-		return
 	}
+}
+
+// TransportHistory возвращает привязки трекеров к транспорту; если uid или stateNumber заданы,
+// только привязки трекера или транспорта.
+func (s *BusTracking) TransportHistory(uid string, stateNumber model.StateNumber) []model.Transport {
+	return s.transport.History(uid, stateNumber)
+}
+
+// ClockSkew возвращает оценку расхождения часов трекеров со временем сервера; если uid задан, только для него.
+// Если оценка расхождения отключена, возвращается nil.
+func (s *BusTracking) ClockSkew(uid string) []model.ClockSkew {
+	if s.clock == nil {
+		return nil
+	}
+	return s.clock.Stats(uid, time.Now())
+}
+
+// Overrides возвращает назначения диспетчера, действующие сейчас или в будущем.
+func (s *BusTracking) Overrides() ([]model.ScheduleOverride, error) {
+	if s.overrides == nil {
+		return nil, ErrOverridesDisabled
+	}
+	return s.overrides.List(time.Now()), nil
+}
 
-	route, err := s.route.GetRoute(schedule.Number)
+// CreateOverride назначает транспорт на маршрут на период действия с приоритетом над расписанием.
+// Если маршрут отсутствует в справочнике, возвращается repository.ErrNotFound.
+func (s *BusTracking) CreateOverride(ctx context.Context, override model.ScheduleOverride) (model.ScheduleOverride, error) {
+	if s.overrides == nil {
+		return override, ErrOverridesDisabled
+	}
+	if _, err := s.route.GetRoute(override.Schedule.Number); err != nil {
+		return override, fmt.Errorf("get route %s: %w", override.Schedule.Number, err)
+	}
+	override.CreatedAt = time.Now()
+	override, err := s.overrides.Create(override)
 	if err != nil {
-		l := slog.With(
-			slog.String("route_number", schedule.Number.String()),
-		)
-		if errors.Is(err, repository.ErrNotFound) {
-			l.WarnContext(ctx, "not found route")
-			// TODO тут записываем что есть какой то UID сигнала по которому нет данных о привязке к транспорту
-			return
-		}
-		l.ErrorContext(ctx, "get route", xslog.Error(err))
-		return
+		return override, err
+	}
+	slog.InfoContext(ctx, "schedule override created",
+		slog.String("id", override.ID),
+		slog.String("state_number", override.Schedule.StateNumber.String()),
+		slog.String("route_number", override.Schedule.Number.String()),
+		slog.String("operator", override.CreatedBy),
+	)
+	return override, nil
+}
+
+// DeleteOverride удаляет назначение диспетчера. Если назначение не найдено, возвращается repository.ErrNotFound.
+func (s *BusTracking) DeleteOverride(ctx context.Context, id string, operator string) (model.ScheduleOverride, error) {
+	if s.overrides == nil {
+		return model.ScheduleOverride{}, ErrOverridesDisabled
+	}
+	override, err := s.overrides.Delete(id, operator, time.Now())
+	if err != nil {
+		return override, err
 	}
+	slog.InfoContext(ctx, "schedule override deleted",
+		slog.String("id", override.ID),
+		slog.String("state_number", override.Schedule.StateNumber.String()),
+		slog.String("operator", operator),
+	)
+	return override, nil
+}
+
+// ActiveAlerts возвращает события, которые еще не завершились.
+func (s *BusTracking) ActiveAlerts() []model.Alert {
+	return s.alerts.Active()
+}
+
+// AlertHistory возвращает последние изменения состояния событий в порядке их публикации.
+func (s *BusTracking) AlertHistory() []model.Alert {
+	return s.alerts.History()
+}
+
+// Vehicles возвращает последнее известное состояние всех транспортных средств.
+func (s *BusTracking) Vehicles() []model.VehicleState {
+	return s.state.List()
+}
+
+// Vehicle возвращает последнее известное состояние транспортного средства по госномеру или UID.
+func (s *BusTracking) Vehicle(stateNumber model.StateNumber, uid string) (model.VehicleState, error) {
+	if stateNumber != "" {
+		return s.state.Get(stateNumber)
+	}
+	return s.state.GetByUID(uid)
+}
 
-	s.location.Update(&model.BusTrackingInfo{
-		Route:     route,
-		Transport: transport,
-		Location:  gpsData,
-		Schedule:  schedule,
+// RoutesActivity группирует по маршрутам транспортные средства, данные от которых получены не ранее since.
+func (s *BusTracking) RoutesActivity(since time.Time) []model.RouteActivity {
+	activity := make(map[model.RouteNumber]*model.RouteActivity)
+	for _, v := range s.state.List() {
+		if v.UpdatedAt.Before(since) {
+			continue
+		}
+		item, ok := activity[v.Info.Route.Number]
+		if !ok {
+			item = &model.RouteActivity{Route: v.Info.Route}
+			activity[v.Info.Route.Number] = item
+		}
+		item.StateNumbers = append(item.StateNumbers, v.Info.Transport.StateNumber)
+		if v.Info.Location.Time.After(item.LastUpdate) {
+			item.LastUpdate = v.Info.Location.Time
+		}
+	}
+	items := make([]model.RouteActivity, 0, len(activity))
+	for _, item := range activity {
+		slices.Sort(item.StateNumbers)
+		items = append(items, *item)
+	}
+	slices.SortFunc(items, func(a, b model.RouteActivity) int {
+		return strings.Compare(a.Route.Number.String(), b.Route.Number.String())
 	})
+	return items
+}
+
+// Diagnostics возвращает сведения о GPS-данных, не сопоставленных со справочниками.
+func (s *BusTracking) Diagnostics(kinds ...model.DiagnosticKind) []model.Diagnostic {
+	return s.diag.List(time.Now(), kinds...)
+}
+
+// UsePipeline заменяет конвейер обработки GPS-данных. Вызывается до начала приема данных.
+func (s *BusTracking) UsePipeline(pipeline *Pipeline) {
+	s.pipeline = pipeline
+}
+
+// ProcessGPSData пропускает GPS-данные через конвейер обработки и публикует прошедшие его данные.
+// Данные старее уже известного состояния транспортного средства не публикуются, чтобы метка на карте
+// не возвращалась назад.
+func (s *BusTracking) ProcessGPSData(ctx context.Context, gpsData model.GPS) {
+	for _, info := range s.pipeline.Process(ctx, &model.BusTrackingInfo{Location: gpsData}) {
+		if s.state.Update(*info, time.Now()) {
+			s.location.Update(info)
+		}
+	}
+}
+
+// currentSchedule определяет расписание транспорта. Назначение диспетчера имеет приоритет над расписанием;
+// без ScheduleResolver время GPS-данных должно находиться строго в пределах смены.
+func (s *BusTracking) currentSchedule(
+	stateNumber model.StateNumber,
+	currentTime time.Time,
+) (model.Schedule, model.ScheduleMatch, error) {
+	if s.overrides != nil {
+		schedule, err := s.overrides.GetCurrent(stateNumber, currentTime)
+		if err == nil {
+			return schedule, model.ScheduleOverridden, nil
+		}
+		if !errors.Is(err, repository.ErrNotFound) {
+			return schedule, "", err
+		}
+	}
+	if s.resolver != nil {
+		return s.resolver.Resolve(stateNumber, currentTime)
+	}
+	schedule, err := s.schedule.GetCurrent(stateNumber, currentTime)
+	if err != nil {
+		return schedule, "", err
+	}
+	return schedule, model.ScheduleExact, nil
+}
+
+// clearAlerts завершает события по транспорту, данные от которого не дошли до стадий, отслеживающих события.
+func (s *BusTracking) clearAlerts(ctx context.Context, stateNumber model.StateNumber, gps model.GPS) {
+	var alerts []model.Alert
+	if s.offRoute != nil {
+		alerts = append(alerts, s.offRoute.Clear(stateNumber, gps)...)
+	}
+	if s.headway != nil {
+		alerts = append(alerts, s.headway.Clear(stateNumber, gps)...)
+	}
+	for _, alert := range alerts {
+		s.publishAlert(ctx, alert)
+	}
+}
+
+func (s *BusTracking) publishAlert(ctx context.Context, alert model.Alert) {
+	slog.InfoContext(ctx, "alert",
+		slog.String("kind", alert.Kind.String()),
+		slog.String("state", alert.State.String()),
+		slog.String("state_number", alert.StateNumber.String()),
+		slog.String("route_number", alert.RouteNumber.String()),
+	)
+	if err := s.alerts.Add(alert); err != nil {
+		slog.ErrorContext(ctx, "save alert", xslog.Error(err))
+	}
+	s.alert.Update(&alert)
 }
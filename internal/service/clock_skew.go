@@ -0,0 +1,140 @@
+package service
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bars43ru/bus2map/internal/model"
+)
+
+// ClockSkewRules правила оценки и исправления расхождения часов трекеров со временем сервера
+type ClockSkewRules struct {
+	Window     time.Duration // окно сообщений, по которым оценивается расхождение
+	MinSamples int           // минимальное количество сообщений в окне для автоматической поправки
+	Threshold  time.Duration // расхождение, начиная с которого время исправляется автоматически
+	Auto       bool          // исправлять время по оценке расхождения
+	// Offsets поправки для отдельных трекеров по UID или исходному UID (model.GPS.SourceUID),
+	// имеют приоритет над автоматической поправкой
+	Offsets map[string]time.Duration
+}
+
+type clockSample struct {
+	receivedAt time.Time
+	skew       time.Duration
+}
+
+// ClockSkewEstimator оценивает расхождение часов трекеров по разнице времени сообщения и времени
+// его получения сервером и исправляет время сообщений. Оценкой служит медиана разницы в окне: отдельные
+// сообщения с недостоверным временем и досылка данных из черного ящика на нее не влияют.
+type ClockSkewEstimator struct {
+	rules   ClockSkewRules
+	mu      sync.Mutex
+	samples map[string][]clockSample
+	sources map[string]string // исходный UID трекеров с пространством имен
+}
+
+func NewClockSkewEstimator(rules ClockSkewRules) *ClockSkewEstimator {
+	return &ClockSkewEstimator{
+		rules:   rules,
+		samples: make(map[string][]clockSample),
+		sources: make(map[string]string),
+	}
+}
+
+// Correct учитывает сообщение в оценке расхождения часов трекера и возвращает его с исправленным временем.
+// Сообщения без времени получения в оценке не учитываются.
+func (e *ClockSkewEstimator) Correct(gps model.GPS) model.GPS {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if gps.SourceUID != "" {
+		e.sources[gps.UID] = gps.SourceUID
+	}
+	samples := e.samples[gps.UID]
+	if !gps.ReceivedAt.IsZero() {
+		samples = append(e.prune(samples, gps.ReceivedAt), clockSample{
+			receivedAt: gps.ReceivedAt,
+			skew:       gps.Time.Sub(gps.ReceivedAt),
+		})
+		e.samples[gps.UID] = samples
+	}
+	gps.Time = gps.Time.Add(e.estimate(gps.UID, samples).Offset)
+	return gps
+}
+
+// Stats возвращает оценку расхождения часов трекеров, от которых получены сообщения в пределах окна
+// до момента now, упорядоченную по UID; если uid задан, только для него.
+func (e *ClockSkewEstimator) Stats(uid string, now time.Time) []model.ClockSkew {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var items []model.ClockSkew
+	for id, samples := range e.samples {
+		samples = e.prune(samples, now)
+		if len(samples) == 0 {
+			delete(e.samples, id)
+			delete(e.sources, id)
+			continue
+		}
+		e.samples[id] = samples
+		if uid == "" || id == uid {
+			items = append(items, e.estimate(id, samples))
+		}
+	}
+	slices.SortFunc(items, func(a, b model.ClockSkew) int {
+		return strings.Compare(a.UID, b.UID)
+	})
+	return items
+}
+
+// prune удаляет сообщения, полученные раньше окна до момента now.
+func (e *ClockSkewEstimator) prune(samples []clockSample, now time.Time) []clockSample {
+	return slices.DeleteFunc(samples, func(sample clockSample) bool {
+		return now.Sub(sample.receivedAt) > e.rules.Window
+	})
+}
+
+func (e *ClockSkewEstimator) estimate(uid string, samples []clockSample) model.ClockSkew {
+	stats := model.ClockSkew{
+		UID:        uid,
+		Samples:    len(samples),
+		Correction: model.ClockCorrectionNone,
+	}
+	for i, sample := range samples {
+		if i == 0 || sample.skew < stats.MinSkew {
+			stats.MinSkew = sample.skew
+		}
+		if i == 0 || sample.skew > stats.MaxSkew {
+			stats.MaxSkew = sample.skew
+		}
+		stats.UpdatedAt = sample.receivedAt
+	}
+	stats.Skew = medianSkew(samples)
+	offset, ok := e.rules.Offsets[uid]
+	if source, found := e.sources[uid]; !ok && found {
+		offset, ok = e.rules.Offsets[source]
+	}
+	if ok {
+		stats.Offset, stats.Correction = offset, model.ClockCorrectionConfigured
+		return stats
+	}
+	if e.rules.Auto && len(samples) >= e.rules.MinSamples &&
+		(stats.Skew >= e.rules.Threshold || stats.Skew <= -e.rules.Threshold) {
+		stats.Offset, stats.Correction = -stats.Skew.Round(time.Second), model.ClockCorrectionAuto
+	}
+	return stats
+}
+
+// medianSkew возвращает медиану расхождения, при четном количестве сообщений - большее из средних значений,
+// так как задержка передачи только уменьшает расхождение.
+func medianSkew(samples []clockSample) time.Duration {
+	if len(samples) == 0 {
+		return 0
+	}
+	skews := make([]time.Duration, 0, len(samples))
+	for _, sample := range samples {
+		skews = append(skews, sample.skew)
+	}
+	slices.Sort(skews)
+	return skews[len(skews)/2]
+}
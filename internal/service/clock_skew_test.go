@@ -0,0 +1,70 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+)
+
+func TestClockSkewEstimator(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	e := NewClockSkewEstimator(ClockSkewRules{
+		Window:     10 * time.Minute,
+		MinSamples: 3,
+		Threshold:  2 * time.Minute,
+		Auto:       true,
+		Offsets:    map[string]time.Duration{"fixed": -time.Hour},
+	})
+	// трекер передает местное время UTC+3 как UTC, сообщения доходят с задержкой
+	receive := func(uid string, seconds int, skew, delay time.Duration) model.GPS {
+		receivedAt := start.Add(time.Duration(seconds) * time.Second)
+		return e.Correct(model.GPS{
+			UID:        uid,
+			Time:       receivedAt.Add(skew - delay),
+			ReceivedAt: receivedAt,
+		})
+	}
+
+	gps := receive("local", 0, 3*time.Hour, 2*time.Second)
+	require.Equal(t, start.Add(3*time.Hour-2*time.Second), gps.Time, "too few samples to correct")
+	receive("local", 10, 3*time.Hour, 5*time.Second)
+	gps = receive("local", 20, 3*time.Hour, 30*time.Second)
+	require.Equal(t, start.Add(20*time.Second-25*time.Second), gps.Time,
+		"offset is the median skew in the window")
+	gps = receive("local", 30, 10*365*24*time.Hour, 0)
+	require.Equal(t, start.Add(10*365*24*time.Hour+30*time.Second-3*time.Hour+2*time.Second), gps.Time,
+		"corrupt timestamp does not change the offset")
+
+	for i := range 5 {
+		gps = receive("accurate", i*10, 0, time.Second)
+	}
+	require.Equal(t, start.Add(39*time.Second), gps.Time, "skew below threshold is not corrected")
+
+	gps = receive("fixed", 0, 0, 0)
+	require.Equal(t, start.Add(-time.Hour), gps.Time, "configured offset is applied")
+	gps = e.Correct(model.GPS{UID: "fixed", Time: start})
+	require.Equal(t, start.Add(-time.Hour), gps.Time, "configured offset is applied without receive time")
+	gps = e.Correct(model.GPS{UID: "egts.fixed", SourceUID: "fixed", Time: start})
+	require.Equal(t, start.Add(-time.Hour), gps.Time, "configured offset is matched by source UID")
+
+	stats := e.Stats("", start.Add(time.Minute))
+	require.Len(t, stats, 3)
+	require.Equal(t, model.ClockSkew{
+		UID:        "local",
+		Samples:    4,
+		Skew:       3*time.Hour - 2*time.Second,
+		MinSkew:    3*time.Hour - 30*time.Second,
+		MaxSkew:    10 * 365 * 24 * time.Hour,
+		Offset:     -3*time.Hour + 2*time.Second,
+		Correction: model.ClockCorrectionAuto,
+		UpdatedAt:  start.Add(30 * time.Second),
+	}, stats[2])
+	require.Equal(t, model.ClockCorrectionNone, stats[0].Correction)
+	require.Equal(t, model.ClockCorrectionConfigured, stats[1].Correction)
+
+	require.Len(t, e.Stats("local", start.Add(time.Minute)), 1)
+	require.Empty(t, e.Stats("", start.Add(time.Hour)), "stale trackers are removed")
+}
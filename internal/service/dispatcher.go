@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"sync"
+
+	"github.com/bars43ru/bus2map/internal/model"
+)
+
+// dispatcherStats очереди обработки GPS-данных, доступно по /debug/vars
+var dispatcherStats = expvar.NewMap("gps_dispatcher")
+
+// Overflow поведение при заполненной очереди обработки
+type Overflow string
+
+const (
+	OverflowBlock Overflow = "block" // приемник ожидает освобождения места в очереди
+	OverflowDrop  Overflow = "drop"  // данные отбрасываются
+)
+
+// ParseOverflow возвращает поведение при заполненной очереди по названию.
+func ParseOverflow(s string) (Overflow, error) {
+	switch o := Overflow(s); o {
+	case OverflowBlock, OverflowDrop:
+		return o, nil
+	}
+	return "", fmt.Errorf("unknown queue overflow %q", s)
+}
+
+// DispatcherRules параметры распределения GPS-данных по очередям обработки
+type DispatcherRules struct {
+	Workers   int      // количество очередей и обработчиков
+	QueueSize int      // размер каждой очереди
+	Overflow  Overflow // поведение при заполненной очереди
+}
+
+type dispatchItem struct {
+	ctx context.Context
+	gps model.GPS
+}
+
+// Dispatcher отделяет прием GPS-данных от их обработки. Данные распределяются по очередям по хешу UID,
+// каждую очередь обрабатывает один обработчик, поэтому данные одного трекера обрабатываются в порядке
+// поступления независимо от приемника, а медленная обработка не задерживает чтение из соединения,
+// пока в очереди есть место.
+type Dispatcher struct {
+	process  func(ctx context.Context, gps model.GPS)
+	overflow Overflow
+	queues   []chan dispatchItem
+	enqueued expvar.Int
+	dropped  expvar.Int
+	blocked  expvar.Int
+}
+
+// NewDispatcher создает распределитель, передающий данные из очередей в process.
+// Обработка начинается после запуска Run.
+func NewDispatcher(process func(ctx context.Context, gps model.GPS), rules DispatcherRules) *Dispatcher {
+	d := &Dispatcher{
+		process:  process,
+		overflow: rules.Overflow,
+		queues:   make([]chan dispatchItem, max(rules.Workers, 1)),
+	}
+	for i := range d.queues {
+		d.queues[i] = make(chan dispatchItem, max(rules.QueueSize, 1))
+	}
+	dispatcherStats.Set("enqueued", &d.enqueued)
+	dispatcherStats.Set("dropped", &d.dropped)
+	dispatcherStats.Set("blocked", &d.blocked)
+	dispatcherStats.Set("capacity", expvar.Func(func() any { return cap(d.queues[0]) }))
+	dispatcherStats.Set("depth", expvar.Func(func() any { return d.Depth() }))
+	return d
+}
+
+// ProcessGPSData помещает данные в очередь трекера. Если очередь заполнена, в зависимости от настроек
+// ожидает освобождения места или отбрасывает данные.
+func (d *Dispatcher) ProcessGPSData(ctx context.Context, gps model.GPS) {
+	queue := d.queues[d.shard(gps.UID)]
+	item := dispatchItem{ctx: context.WithoutCancel(ctx), gps: gps}
+	select {
+	case queue <- item:
+		d.enqueued.Add(1)
+		return
+	default:
+	}
+	if d.overflow == OverflowDrop {
+		d.dropped.Add(1)
+		slog.WarnContext(ctx, "gps data queue is full, data dropped", slog.String("uid", gps.UID))
+		return
+	}
+	d.blocked.Add(1)
+	select {
+	case queue <- item:
+		d.enqueued.Add(1)
+	case <-ctx.Done():
+		d.dropped.Add(1)
+	}
+}
+
+// Depth возвращает количество данных, ожидающих обработки, в каждой очереди.
+func (d *Dispatcher) Depth() []int {
+	depth := make([]int, len(d.queues))
+	for i, queue := range d.queues {
+		depth[i] = len(queue)
+	}
+	return depth
+}
+
+// Run запускает обработчики очередей и ожидает их завершения после отмены ctx.
+func (d *Dispatcher) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	for _, queue := range d.queues {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case item := <-queue:
+					d.process(item.ctx, item.gps)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return nil
+}
+
+func (d *Dispatcher) shard(uid string) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(uid))
+	return int(h.Sum32() % uint32(len(d.queues)))
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+)
+
+func TestDispatcher(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		processed = make(map[string][]int)
+	)
+	d := NewDispatcher(func(_ context.Context, gps model.GPS) {
+		mu.Lock()
+		defer mu.Unlock()
+		processed[gps.UID] = append(processed[gps.UID], int(gps.Speed))
+	}, DispatcherRules{Workers: 4, QueueSize: 2, Overflow: OverflowBlock})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- d.Run(ctx) }()
+
+	const points = 100
+	var wg sync.WaitGroup
+	for receiver := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			uid := fmt.Sprintf("uid-%d", receiver)
+			for i := range points {
+				d.ProcessGPSData(context.Background(), model.GPS{UID: uid, Speed: uint32(i)})
+			}
+		}()
+	}
+	wg.Wait()
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		n := 0
+		for _, speeds := range processed {
+			n += len(speeds)
+		}
+		return n == 8*points
+	}, time.Second, 10*time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+
+	for uid, speeds := range processed {
+		require.Len(t, speeds, points, uid)
+		require.IsIncreasing(t, speeds, "order of %s is preserved", uid)
+	}
+	require.Equal(t, []int{0, 0, 0, 0}, d.Depth())
+}
+
+func TestDispatcher_Overflow(t *testing.T) {
+	d := NewDispatcher(func(context.Context, model.GPS) {}, DispatcherRules{
+		Workers:   1,
+		QueueSize: 2,
+		Overflow:  OverflowDrop,
+	})
+	for range 3 {
+		d.ProcessGPSData(context.Background(), model.GPS{UID: "A"})
+	}
+	require.Equal(t, []int{2}, d.Depth())
+	require.Equal(t, int64(1), d.dropped.Value())
+
+	d.overflow = OverflowBlock
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	d.ProcessGPSData(ctx, model.GPS{UID: "A"})
+	require.Equal(t, int64(1), d.blocked.Value())
+	require.Equal(t, int64(2), d.dropped.Value(), "data is dropped when receiver context is done")
+}
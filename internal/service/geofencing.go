@@ -0,0 +1,53 @@
+package service
+
+import (
+	"expvar"
+	"slices"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+	"github.com/bars43ru/bus2map/pkg/geo"
+)
+
+// outsideServiceArea причина подавления точки вне всех областей обслуживания
+const outsideServiceArea = "outside_service_area"
+
+// suppressedGPS количество неопубликованных точек в разрезе причин, доступно по /debug/vars
+var suppressedGPS = expvar.NewMap("gps_suppressed")
+
+// Geofencing определяет геозоны, в которых находится транспорт, и решает, публиковать ли его координаты.
+type Geofencing struct {
+	geofence        *repository.Geofence
+	suppress        []model.GeofenceType
+	suppressOutside bool
+}
+
+// NewGeofencing создает проверку геозон: точки в зонах типов suppress не публикуются,
+// как и точки вне всех областей обслуживания, если suppressOutside и такие области заданы.
+func NewGeofencing(geofence *repository.Geofence, suppress []model.GeofenceType, suppressOutside bool) *Geofencing {
+	return &Geofencing{
+		geofence:        geofence,
+		suppress:        suppress,
+		suppressOutside: suppressOutside,
+	}
+}
+
+// Check возвращает геозоны точки и причину, по которой ее не нужно публиковать (пустая, если публиковать).
+func (g *Geofencing) Check(gps model.GPS) ([]model.Zone, string) {
+	zones := g.geofence.Find(geo.Point{Latitude: gps.Latitude, Longitude: gps.Longitude})
+	inServiceArea := false
+	for _, zone := range zones {
+		if slices.Contains(g.suppress, zone.Type) {
+			suppressedGPS.Add(zone.Type.String(), 1)
+			return zones, zone.Type.String()
+		}
+		if zone.Type == model.GeofenceServiceArea {
+			inServiceArea = true
+		}
+	}
+	if g.suppressOutside && !inServiceArea && g.geofence.HasType(model.GeofenceServiceArea) {
+		suppressedGPS.Add(outsideServiceArea, 1)
+		return zones, outsideServiceArea
+	}
+	return zones, ""
+}
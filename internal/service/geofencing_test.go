@@ -0,0 +1,81 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+	"github.com/bars43ru/bus2map/pkg/geo"
+)
+
+func TestGeofencing_Check(t *testing.T) {
+	square := func(lat, lon, size float64) geo.MultiPolygon {
+		return geo.MultiPolygon{{{
+			{Latitude: lat, Longitude: lon},
+			{Latitude: lat, Longitude: lon + size},
+			{Latitude: lat + size, Longitude: lon + size},
+			{Latitude: lat + size, Longitude: lon},
+			{Latitude: lat, Longitude: lon},
+		}}}
+	}
+	geofence := repository.NewGeofence("")
+	geofence.Replace([]model.Geofence{
+		{Zone: model.Zone{ID: "city", Type: model.GeofenceServiceArea}, Area: square(58.5, 49.5, 0.2)},
+		{Zone: model.Zone{ID: "depot", Type: model.GeofenceDepot}, Area: square(58.6, 49.6, 0.01)},
+		{Zone: model.Zone{ID: "secret", Type: model.GeofenceNoPublish}, Area: square(58.65, 49.65, 0.01)},
+	})
+	gps := func(lat, lon float64) model.GPS {
+		return model.GPS{UID: "1", Latitude: lat, Longitude: lon}
+	}
+
+	tests := []struct {
+		name            string
+		suppress        []model.GeofenceType
+		suppressOutside bool
+		gps             model.GPS
+		zones           []string
+		reason          string
+	}{
+		{
+			name:  "service area",
+			gps:   gps(58.55, 49.55),
+			zones: []string{"city"},
+		},
+		{
+			name:     "suppressed zone",
+			suppress: []model.GeofenceType{model.GeofenceNoPublish},
+			gps:      gps(58.655, 49.655),
+			zones:    []string{"city", "secret"},
+			reason:   model.GeofenceNoPublish.String(),
+		},
+		{
+			name:     "zone of other type is published",
+			suppress: []model.GeofenceType{model.GeofenceNoPublish},
+			gps:      gps(58.605, 49.605),
+			zones:    []string{"city", "depot"},
+		},
+		{
+			name:            "outside service area",
+			suppressOutside: true,
+			gps:             gps(58.8, 49.55),
+			reason:          outsideServiceArea,
+		},
+		{
+			name: "outside service area is published by default",
+			gps:  gps(58.8, 49.55),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zones, reason := NewGeofencing(geofence, tt.suppress, tt.suppressOutside).Check(tt.gps)
+			ids := make([]string, 0, len(zones))
+			for _, zone := range zones {
+				ids = append(ids, zone.ID)
+			}
+			require.ElementsMatch(t, tt.zones, ids)
+			require.Equal(t, tt.reason, reason)
+		})
+	}
+}
@@ -0,0 +1,309 @@
+package service
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bars43ru/bus2map/internal/model"
+)
+
+// HeadwayRules правила контроля интервалов движения. Сближение фиксируется, если интервал до впереди
+// идущего транспорта меньше планового в BunchingRatio раз, разрыв - если больше в GapRatio раз.
+type HeadwayRules struct {
+	Default       time.Duration                       // плановый интервал для маршрутов, не указанных в Routes
+	Routes        map[model.RouteNumber]time.Duration // плановые интервалы маршрутов
+	BunchingRatio float64
+	GapRatio      float64
+	ActiveWindow  time.Duration // транспорт без данных дольше этого времени исключается из контроля
+	History       time.Duration // время хранения пройденного пути транспорта
+}
+
+func (r HeadwayRules) planned(route model.RouteNumber) time.Duration {
+	if d, ok := r.Routes[route]; ok {
+		return d
+	}
+	return r.Default
+}
+
+type alongSample struct {
+	along float64
+	time  time.Time
+}
+
+type headwayVehicle struct {
+	headway model.VehicleHeadway
+	uid     string
+	last    model.GPS
+	trail   []alongSample
+	status  model.AlertKind // пусто, если интервал в норме
+	since   time.Time       // время возникновения события status
+}
+
+type routeHeadway struct {
+	vehicles map[model.StateNumber]*headwayVehicle
+	bunching uint64
+	gaps     uint64
+}
+
+// HeadwayMonitor упорядочивает транспорт маршрута по пройденному расстоянию и сравнивает фактические
+// интервалы между соседними транспортными средствами с плановым.
+type HeadwayMonitor struct {
+	rules    HeadwayRules
+	mu       sync.Mutex
+	routes   map[model.RouteNumber]*routeHeadway
+	vehicles map[model.StateNumber]model.RouteNumber
+}
+
+func NewHeadwayMonitor(rules HeadwayRules) *HeadwayMonitor {
+	return &HeadwayMonitor{
+		rules:    rules,
+		routes:   make(map[model.RouteNumber]*routeHeadway),
+		vehicles: make(map[model.StateNumber]model.RouteNumber),
+	}
+}
+
+// Process учитывает положение транспорта, привязанного к линии маршрута, и возвращает изменения
+// состояния событий сближения и разрыва по транспорту маршрута.
+func (m *HeadwayMonitor) Process(info model.BusTrackingInfo) []model.Alert {
+	if !info.Match.Matched {
+		return nil
+	}
+	stateNumber := info.Transport.StateNumber
+	number := info.Route.Number
+	now := info.Location.Time
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var alerts []model.Alert
+	if prev, ok := m.vehicles[stateNumber]; ok && prev != number {
+		alerts = append(alerts, m.remove(prev, stateNumber, info.Location)...)
+	}
+	m.vehicles[stateNumber] = number
+	route, ok := m.routes[number]
+	if !ok {
+		route = &routeHeadway{vehicles: make(map[model.StateNumber]*headwayVehicle)}
+		m.routes[number] = route
+	}
+	v, ok := route.vehicles[stateNumber]
+	if !ok {
+		v = &headwayVehicle{}
+		route.vehicles[stateNumber] = v
+	}
+	v.uid = info.Location.UID
+	v.last = info.Location
+	v.headway.StateNumber = stateNumber
+	v.headway.DistanceAlong = info.Match.DistanceAlong
+	v.trail = append(v.trail, alongSample{along: info.Match.DistanceAlong, time: now})
+	i := 0
+	for i < len(v.trail)-1 && now.Sub(v.trail[i].time) > m.rules.History {
+		i++
+	}
+	v.trail = v.trail[i:]
+
+	alerts = append(alerts, m.evict(number, route, now)...)
+
+	planned := m.rules.planned(number)
+	for _, v := range m.order(route, now) {
+		status := model.AlertKind("")
+		if v.headway.Known {
+			switch {
+			case v.headway.Headway < time.Duration(float64(planned)*m.rules.BunchingRatio):
+				status = model.AlertBunching
+			case v.headway.Headway > time.Duration(float64(planned)*m.rules.GapRatio):
+				status = model.AlertGap
+			}
+		}
+		if status == v.status {
+			continue
+		}
+		if v.status != "" {
+			alerts = append(alerts, m.alert(number, v, v.status, model.AlertCleared))
+		}
+		if status != "" {
+			v.since = v.last.Time
+			alerts = append(alerts, m.alert(number, v, status, model.AlertRaised))
+			if status == model.AlertBunching {
+				route.bunching++
+			} else {
+				route.gaps++
+			}
+		}
+		v.status = status
+	}
+	return alerts
+}
+
+// Clear исключает из контроля транспорт, данные от которого больше не проверяются (нет расписания
+// или маршрута, публикация подавлена геозоной), и завершает его активное событие.
+func (m *HeadwayMonitor) Clear(stateNumber model.StateNumber, gps model.GPS) []model.Alert {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	number, ok := m.vehicles[stateNumber]
+	if !ok {
+		return nil
+	}
+	return m.remove(number, stateNumber, gps)
+}
+
+// Sweep исключает из контроля транспорт, от которого нет данных дольше ActiveWindow к моменту now,
+// и завершает его активные события.
+func (m *HeadwayMonitor) Sweep(now time.Time) []model.Alert {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var alerts []model.Alert
+	for number, route := range m.routes {
+		for _, alert := range m.evict(number, route, now) {
+			alert.Time = now
+			alerts = append(alerts, alert)
+		}
+	}
+	return alerts
+}
+
+// evict исключает из контроля маршрута транспорт, от которого нет данных дольше ActiveWindow к моменту now.
+func (m *HeadwayMonitor) evict(number model.RouteNumber, route *routeHeadway, now time.Time) []model.Alert {
+	var alerts []model.Alert
+	for stateNumber, v := range route.vehicles {
+		if m.stale(v, now) {
+			alerts = append(alerts, m.remove(number, stateNumber, v.last)...)
+		}
+	}
+	return alerts
+}
+
+func (m *HeadwayMonitor) stale(v *headwayVehicle, now time.Time) bool {
+	return now.Sub(v.last.Time) > m.rules.ActiveWindow
+}
+
+// order упорядочивает транспорт маршрута, данные от которого получены в пределах ActiveWindow к моменту now,
+// по убыванию пройденного расстояния и вычисляет интервалы.
+func (m *HeadwayMonitor) order(route *routeHeadway, now time.Time) []*headwayVehicle {
+	items := make([]*headwayVehicle, 0, len(route.vehicles))
+	for _, v := range route.vehicles {
+		if !m.stale(v, now) {
+			items = append(items, v)
+		}
+	}
+	slices.SortFunc(items, func(a, b *headwayVehicle) int {
+		if c := cmp.Compare(b.headway.DistanceAlong, a.headway.DistanceAlong); c != 0 {
+			return c
+		}
+		return strings.Compare(a.headway.StateNumber.String(), b.headway.StateNumber.String())
+	})
+	for i, v := range items {
+		v.headway.Leader, v.headway.Headway, v.headway.Known = "", 0, false
+		if i == 0 {
+			continue
+		}
+		leader := items[i-1]
+		v.headway.Leader = leader.headway.StateNumber
+		if passed, ok := passTime(leader.trail, v.headway.DistanceAlong); ok && !passed.After(v.last.Time) {
+			v.headway.Headway = v.last.Time.Sub(passed)
+			v.headway.Known = true
+		}
+	}
+	return items
+}
+
+// passTime возвращает время последнего прохождения точки along по пройденному пути.
+func passTime(trail []alongSample, along float64) (time.Time, bool) {
+	for i := len(trail) - 1; i > 0; i-- {
+		a, b := trail[i-1], trail[i]
+		if a.along > along || b.along < along || !b.time.After(a.time) {
+			continue
+		}
+		if b.along == a.along {
+			return a.time, true
+		}
+		k := (along - a.along) / (b.along - a.along)
+		return a.time.Add(time.Duration(float64(b.time.Sub(a.time)) * k)), true
+	}
+	return time.Time{}, false
+}
+
+// remove исключает транспорт из контроля маршрута и завершает его активное событие.
+func (m *HeadwayMonitor) remove(number model.RouteNumber, stateNumber model.StateNumber, gps model.GPS) []model.Alert {
+	route, ok := m.routes[number]
+	if !ok {
+		return nil
+	}
+	v, ok := route.vehicles[stateNumber]
+	if !ok {
+		return nil
+	}
+	delete(route.vehicles, stateNumber)
+	if m.vehicles[stateNumber] == number {
+		delete(m.vehicles, stateNumber)
+	}
+	if v.status == "" {
+		return nil
+	}
+	v.last = gps
+	return []model.Alert{m.alert(number, v, v.status, model.AlertCleared)}
+}
+
+func (m *HeadwayMonitor) alert(
+	number model.RouteNumber,
+	v *headwayVehicle,
+	kind model.AlertKind,
+	state model.AlertState,
+) model.Alert {
+	return model.Alert{
+		Kind:        kind,
+		State:       state,
+		StateNumber: v.headway.StateNumber,
+		UID:         v.uid,
+		RouteNumber: number,
+		Since:       v.since,
+		Time:        v.last.Time,
+		Location:    v.last,
+		Headway:     v.headway.Headway,
+		Leader:      v.headway.Leader,
+	}
+}
+
+// Headways возвращает интервалы движения по маршрутам на момент now, упорядоченные по номеру маршрута;
+// транспорт без данных дольше ActiveWindow не учитывается. Если number задан, возвращается только указанный маршрут.
+func (m *HeadwayMonitor) Headways(number model.RouteNumber, now time.Time) []model.RouteHeadway {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var items []model.RouteHeadway
+	for n, route := range m.routes {
+		if number != "" && n != number {
+			continue
+		}
+		item := model.RouteHeadway{
+			RouteNumber:    n,
+			Planned:        m.rules.planned(n),
+			BunchingEvents: route.bunching,
+			GapEvents:      route.gaps,
+		}
+		var (
+			sum   time.Duration
+			known int
+		)
+		for _, v := range m.order(route, now) {
+			item.Vehicles = append(item.Vehicles, v.headway)
+			if !v.headway.Known {
+				continue
+			}
+			if known == 0 || v.headway.Headway < item.Min {
+				item.Min = v.headway.Headway
+			}
+			item.Max = max(item.Max, v.headway.Headway)
+			sum += v.headway.Headway
+			known++
+		}
+		if known > 0 {
+			item.Mean = sum / time.Duration(known)
+		}
+		items = append(items, item)
+	}
+	slices.SortFunc(items, func(a, b model.RouteHeadway) int {
+		return strings.Compare(a.RouteNumber.String(), b.RouteNumber.String())
+	})
+	return items
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+)
+
+func TestHeadwayMonitor(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	m := NewHeadwayMonitor(HeadwayRules{
+		Default:       10 * time.Minute,
+		BunchingRatio: 0.3,
+		GapRatio:      1.8,
+		ActiveWindow:  time.Hour,
+		History:       2 * time.Hour,
+	})
+	point := func(stateNumber model.StateNumber, seconds int, along float64) model.BusTrackingInfo {
+		return model.BusTrackingInfo{
+			Route:     model.Route{Number: "1"},
+			Transport: model.Transport{StateNumber: stateNumber},
+			Location:  model.GPS{UID: stateNumber.String(), Time: start.Add(time.Duration(seconds) * time.Second)},
+			Match:     model.RouteMatch{Matched: true, HasShape: true, DistanceAlong: along},
+		}
+	}
+
+	require.Empty(t, m.Process(point("A", 0, 0)))
+	require.Empty(t, m.Process(point("A", 600, 6000)))
+
+	alerts := m.Process(point("B", 60, 0))
+	require.Len(t, alerts, 1)
+	require.Equal(t, model.AlertBunching, alerts[0].Kind)
+	require.Equal(t, model.AlertRaised, alerts[0].State)
+	require.Equal(t, model.StateNumber("A"), alerts[0].Leader)
+	require.Equal(t, time.Minute, alerts[0].Headway)
+
+	alerts = m.Process(point("B", 700, 3000))
+	require.Len(t, alerts, 1)
+	require.Equal(t, model.AlertCleared, alerts[0].State)
+
+	alerts = m.Process(point("C", 2000, 0))
+	require.Len(t, alerts, 1)
+	require.Equal(t, model.AlertGap, alerts[0].Kind)
+	require.Equal(t, 1940*time.Second, alerts[0].Headway)
+
+	headways := m.Headways("1", start.Add(2000*time.Second))
+	require.Len(t, headways, 1)
+	require.Equal(t, uint64(1), headways[0].BunchingEvents)
+	require.Equal(t, uint64(1), headways[0].GapEvents)
+	require.Len(t, headways[0].Vehicles, 3)
+	require.Equal(t, model.StateNumber("A"), headways[0].Vehicles[0].StateNumber)
+	require.False(t, headways[0].Vehicles[0].Known)
+	require.Equal(t, 400*time.Second, headways[0].Min)
+	require.Equal(t, 1940*time.Second, headways[0].Max)
+
+	t.Run("route change clears alert", func(t *testing.T) {
+		info := point("C", 2010, 0)
+		info.Route.Number = "2"
+		alerts := m.Process(info)
+		require.Len(t, alerts, 1)
+		require.Equal(t, model.AlertGap, alerts[0].Kind)
+		require.Equal(t, model.AlertCleared, alerts[0].State)
+		require.Equal(t, model.RouteNumber("1"), alerts[0].RouteNumber)
+	})
+
+	t.Run("silent vehicles are evicted", func(t *testing.T) {
+		headways := m.Headways("1", start.Add(4250*time.Second))
+		require.Len(t, headways[0].Vehicles, 1, "stale vehicles are not listed")
+		require.Equal(t, model.StateNumber("B"), headways[0].Vehicles[0].StateNumber)
+
+		alerts := m.Process(point("D", 720, 2990))
+		require.Len(t, alerts, 1)
+		require.Equal(t, model.AlertBunching, alerts[0].Kind)
+
+		require.Empty(t, m.Sweep(start.Add(720*time.Second+time.Hour)))
+		now := start.Add(721*time.Second + time.Hour)
+		alerts = m.Sweep(now)
+		require.Len(t, alerts, 1)
+		require.Equal(t, model.StateNumber("D"), alerts[0].StateNumber)
+		require.Equal(t, model.AlertCleared, alerts[0].State)
+		require.Equal(t, now, alerts[0].Time)
+		require.Empty(t, m.Headways("1", now)[0].Vehicles)
+	})
+	t.Run("dropped vehicle clears alert", func(t *testing.T) {
+		m.Process(point("E", 4990, 900))
+		m.Process(point("E", 5000, 1000))
+		alerts := m.Process(point("F", 5010, 990))
+		require.Len(t, alerts, 1)
+		alerts = m.Clear("F", model.GPS{Time: start.Add(5020 * time.Second)})
+		require.Len(t, alerts, 1)
+		require.Equal(t, model.AlertCleared, alerts[0].State)
+		require.Empty(t, m.Clear("F", model.GPS{}))
+	})
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+	"github.com/bars43ru/bus2map/pkg/geo"
+)
+
+type matchState struct {
+	route model.RouteNumber
+	along float64
+}
+
+// MapMatcher привязывает точки к линии маршрута, по которому транспорт работает по расписанию.
+type MapMatcher struct {
+	shapes    *repository.RouteShape
+	maxOffset float64
+	tolerance float64
+	mu        sync.Mutex
+	vehicles  map[string]matchState
+}
+
+// NewMapMatcher создает привязку к маршруту: точка считается привязанной, если удалена от линии
+// не более чем на maxOffset метров. Среди участков линии, удаленных от точки не более чем на tolerance
+// метров дальше ближайшего, выбирается ближайший к предыдущему положению транспорта на маршруте.
+func NewMapMatcher(shapes *repository.RouteShape, maxOffset float64, tolerance float64) *MapMatcher {
+	return &MapMatcher{
+		shapes:    shapes,
+		maxOffset: maxOffset,
+		tolerance: tolerance,
+		vehicles:  make(map[string]matchState),
+	}
+}
+
+// Match возвращает положение точки относительно линии маршрута.
+// Если геометрия маршрута не задана, возвращается пустой результат.
+func (m *MapMatcher) Match(route model.RouteNumber, gps model.GPS) (model.RouteMatch, error) {
+	shape, err := m.shapes.Get(route)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return model.RouteMatch{}, nil
+		}
+		return model.RouteMatch{}, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	along := -1.0
+	if prev, ok := m.vehicles[gps.UID]; ok && prev.route == route {
+		along = prev.along
+	}
+	p := shape.Line.ProjectNear(geo.Point{Latitude: gps.Latitude, Longitude: gps.Longitude}, along, m.tolerance)
+	match := model.RouteMatch{
+		Matched:       p.Offset <= m.maxOffset,
+		HasShape:      true,
+		Position:      p.Point,
+		DistanceAlong: p.DistanceAlong,
+		Offset:        p.Offset,
+	}
+	// Положение вне маршрута не используется как подсказка, чтобы после возврата на маршрут
+	// привязка не зависела от точки схода.
+	if match.Matched {
+		m.vehicles[gps.UID] = matchState{route: route, along: p.DistanceAlong}
+	} else if prev, ok := m.vehicles[gps.UID]; ok && prev.route != route {
+		delete(m.vehicles, gps.UID)
+	}
+	return match, nil
+}
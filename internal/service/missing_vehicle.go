@@ -0,0 +1,160 @@
+package service
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+)
+
+// MissingRules правила выявления транспорта, который работает по расписанию, но не передает данные.
+type MissingRules struct {
+	LateStart    time.Duration // нет данных с начала смены дольше этого времени - опоздание с выходом на линию
+	NotReporting time.Duration // нет данных с начала смены дольше этого времени - транспорт не выходит на связь
+	Silent       time.Duration // нет данных во время смены дольше этого времени - транспорт пропал со связи
+	Interval     time.Duration // периодичность проверки
+}
+
+// MissingVehicleDetector сравнивает активные записи расписания и назначения диспетчера со временем
+// последнего получения данных от транспорта и формирует события для диспетчера.
+type MissingVehicleDetector struct {
+	schedule  *repository.Schedule
+	overrides *repository.Override
+	state     *repository.VehicleState
+	rules     MissingRules
+	mu        sync.Mutex
+	current   map[model.StateNumber]model.MissingVehicle
+}
+
+// NewMissingVehicleDetector создает детектор отсутствующего транспорта. Назначения диспетчера overrides
+// имеют приоритет над расписанием; если overrides равен nil, учитывается только расписание.
+func NewMissingVehicleDetector(
+	schedule *repository.Schedule,
+	overrides *repository.Override,
+	state *repository.VehicleState,
+	rules MissingRules,
+) *MissingVehicleDetector {
+	return &MissingVehicleDetector{
+		schedule:  schedule,
+		overrides: overrides,
+		state:     state,
+		rules:     rules,
+		current:   make(map[model.StateNumber]model.MissingVehicle),
+	}
+}
+
+// Interval возвращает периодичность проверки.
+func (d *MissingVehicleDetector) Interval() time.Duration {
+	return d.rules.Interval
+}
+
+// Check обновляет список отсутствующего транспорта на момент now и возвращает изменения состояния событий.
+// Событие завершается, когда от транспорта поступают данные или заканчивается смена по расписанию.
+func (d *MissingVehicleDetector) Check(now time.Time) []model.Alert {
+	active := d.schedule.ListActive(now)
+	slices.SortStableFunc(active, func(a, b model.Schedule) int {
+		return a.From.Compare(b.From)
+	})
+	next := make(map[model.StateNumber]model.MissingVehicle)
+	checked := make(map[model.StateNumber]bool)
+	if d.overrides != nil {
+		// назначение диспетчера заменяет расписание транспорта
+		active = append(d.overrides.ListActive(now), active...)
+	}
+	for _, item := range active {
+		if checked[item.StateNumber] {
+			continue
+		}
+		checked[item.StateNumber] = true
+		if missing, ok := d.evaluate(item, now); ok {
+			next[item.StateNumber] = missing
+		}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var alerts []model.Alert
+	for stateNumber, prev := range d.current {
+		if cur, ok := next[stateNumber]; !ok || cur.Kind != prev.Kind {
+			alerts = append(alerts, d.alert(prev, model.AlertCleared, now))
+		}
+	}
+	for stateNumber, cur := range next {
+		if prev, ok := d.current[stateNumber]; !ok || prev.Kind != cur.Kind {
+			alerts = append(alerts, d.alert(cur, model.AlertRaised, now))
+		}
+	}
+	d.current = next
+	slices.SortStableFunc(alerts, func(a, b model.Alert) int {
+		if c := strings.Compare(a.StateNumber.String(), b.StateNumber.String()); c != 0 {
+			return c
+		}
+		// завершение предыдущего события раньше возникновения следующего
+		return strings.Compare(a.State.String(), b.State.String())
+	})
+	return alerts
+}
+
+func (d *MissingVehicleDetector) evaluate(item model.Schedule, now time.Time) (model.MissingVehicle, bool) {
+	missing := model.MissingVehicle{
+		StateNumber: item.StateNumber,
+		Schedule:    item,
+	}
+	lastSeen, ok := d.state.LastSeen(item.StateNumber)
+	if ok {
+		missing.LastSeen = lastSeen
+	}
+	if ok && !lastSeen.Before(item.From) {
+		if now.Sub(lastSeen) <= d.rules.Silent {
+			return missing, false
+		}
+		missing.Kind = model.AlertWentSilent
+		missing.Since = lastSeen.Add(d.rules.Silent)
+		return missing, true
+	}
+	late := now.Sub(item.From)
+	switch {
+	case late > d.rules.NotReporting:
+		missing.Kind = model.AlertNotReporting
+		missing.Since = item.From.Add(d.rules.NotReporting)
+	case late > d.rules.LateStart:
+		missing.Kind = model.AlertLateStart
+		missing.Since = item.From.Add(d.rules.LateStart)
+	default:
+		return missing, false
+	}
+	return missing, true
+}
+
+func (d *MissingVehicleDetector) alert(missing model.MissingVehicle, state model.AlertState, now time.Time) model.Alert {
+	alert := model.Alert{
+		Kind:        missing.Kind,
+		State:       state,
+		StateNumber: missing.StateNumber,
+		RouteNumber: missing.Schedule.Number,
+		Since:       missing.Since,
+		Time:        now,
+	}
+	if v, err := d.state.Get(missing.StateNumber); err == nil {
+		alert.UID = v.Info.Location.UID
+		alert.Location = v.Info.Location
+	}
+	return alert
+}
+
+// List возвращает транспорт, отсутствующий по результатам последней проверки, упорядоченный по госномеру.
+func (d *MissingVehicleDetector) List() []model.MissingVehicle {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	items := make([]model.MissingVehicle, 0, len(d.current))
+	for _, item := range d.current {
+		items = append(items, item)
+	}
+	slices.SortFunc(items, func(a, b model.MissingVehicle) int {
+		return strings.Compare(a.StateNumber.String(), b.StateNumber.String())
+	})
+	return items
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+)
+
+func TestMissingVehicleDetector(t *testing.T) {
+	start := time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC)
+	schedule := repository.NewSchedule("", nil)
+	schedule.Replace([]model.Schedule{
+		{Number: "1", StateNumber: "A001AA", From: start, To: start.Add(8 * time.Hour)},
+		{Number: "2", StateNumber: "B002BB", From: start, To: start.Add(time.Hour)},
+	})
+	state := repository.NewVehicleState()
+	d := NewMissingVehicleDetector(schedule, nil, state, MissingRules{
+		LateStart:    5 * time.Minute,
+		NotReporting: 20 * time.Minute,
+		Silent:       3 * time.Minute,
+	})
+	at := func(minutes int) time.Time {
+		return start.Add(time.Duration(minutes) * time.Minute)
+	}
+	kinds := func(alerts []model.Alert) []string {
+		var items []string
+		for _, alert := range alerts {
+			items = append(items, alert.StateNumber.String()+" "+alert.Kind.String()+" "+alert.State.String())
+		}
+		return items
+	}
+
+	state.Seen("A001AA", at(-30))
+	require.Empty(t, d.Check(at(4)), "previous day data does not count, but grace period is not over")
+
+	alerts := d.Check(at(6))
+	require.Equal(t, []string{"A001AA late_start raised", "B002BB late_start raised"}, kinds(alerts))
+	require.Equal(t, at(5), alerts[0].Since)
+	require.Equal(t, model.RouteNumber("1"), alerts[0].RouteNumber)
+	require.Empty(t, d.Check(at(7)))
+
+	state.Seen("A001AA", at(10))
+	alerts = d.Check(at(21))
+	require.Equal(t, []string{
+		"A001AA late_start cleared",
+		"A001AA went_silent raised",
+		"B002BB late_start cleared",
+		"B002BB not_reporting raised",
+	}, kinds(alerts))
+	require.Equal(t, at(13), alerts[1].Since)
+
+	missing := d.List()
+	require.Len(t, missing, 2)
+	require.Equal(t, at(10), missing[0].LastSeen)
+	require.True(t, missing[1].LastSeen.IsZero())
+
+	state.Seen("A001AA", at(22))
+	require.Equal(t, []string{"A001AA went_silent cleared"}, kinds(d.Check(at(23))))
+
+	state.Seen("A001AA", at(60))
+	require.Equal(t, []string{"B002BB not_reporting cleared"}, kinds(d.Check(at(61))), "schedule ended")
+	require.Empty(t, d.List())
+}
+
+func TestMissingVehicleDetector_Overrides(t *testing.T) {
+	start := time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC)
+	schedule := repository.NewSchedule("", nil)
+	schedule.Replace([]model.Schedule{
+		{Number: "1", StateNumber: "A001AA", From: start, To: start.Add(8 * time.Hour)},
+	})
+	overrides, err := repository.NewOverride(filepath.Join(t.TempDir(), "overrides.json"), nil)
+	require.NoError(t, err)
+	for _, item := range []model.Schedule{
+		{Number: "2", StateNumber: "A001AA", From: start.Add(-time.Hour), To: start.Add(8 * time.Hour)},
+		{Number: "3", StateNumber: "B002BB", From: start, To: start.Add(time.Hour)},
+	} {
+		_, err := overrides.Create(model.ScheduleOverride{Schedule: item, CreatedBy: "dispatcher", CreatedAt: start})
+		require.NoError(t, err)
+	}
+	d := NewMissingVehicleDetector(schedule, overrides, repository.NewVehicleState(), MissingRules{
+		LateStart:    5 * time.Minute,
+		NotReporting: 20 * time.Minute,
+		Silent:       3 * time.Minute,
+	})
+
+	alerts := d.Check(start.Add(6 * time.Minute))
+	require.Len(t, alerts, 2)
+	require.Equal(t, model.StateNumber("A001AA"), alerts[0].StateNumber)
+	require.Equal(t, model.AlertNotReporting, alerts[0].Kind, "override replaces the schedule shift")
+	require.Equal(t, model.RouteNumber("2"), alerts[0].RouteNumber)
+	require.Equal(t, model.StateNumber("B002BB"), alerts[1].StateNumber, "vehicle without schedule is assigned by override")
+	require.Equal(t, model.AlertLateStart, alerts[1].Kind)
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/model/transport_type"
+	"github.com/bars43ru/bus2map/pkg/geo"
+)
+
+// MotionMode способ использования курса и скорости, вычисленных по соседним точкам
+type MotionMode string
+
+const (
+	MotionOff      MotionMode = "off"      // использовать данные трекера
+	MotionFill     MotionMode = "fill"     // заменять только нулевые значения трекера
+	MotionOverride MotionMode = "override" // всегда заменять значения трекера
+)
+
+func ParseMotionMode(value string) (MotionMode, error) {
+	switch m := MotionMode(value); m {
+	case MotionOff, MotionFill, MotionOverride:
+		return m, nil
+	}
+	return "", fmt.Errorf("unexpected motion mode `%s`", value)
+}
+
+// MotionPolicy правила вычисления курса и скорости. Режим выбирается по первому совпадению:
+// госномер транспорта, тип транспорта, приемник, режим по умолчанию.
+type MotionPolicy struct {
+	Default        MotionMode
+	Vehicles       map[model.StateNumber]MotionMode
+	TransportTypes map[transport_type.Type]MotionMode
+	Receivers      map[model.Receiver]MotionMode
+	MinDistance    float64       // минимальное перемещение в метрах для пересчета курса и скорости
+	MaxInterval    time.Duration // максимальный интервал между точками, по которым вычисляется движение
+}
+
+func (p MotionPolicy) mode(transport model.Transport, gps model.GPS) MotionMode {
+	if m, ok := p.Vehicles[transport.StateNumber]; ok {
+		return m
+	}
+	if m, ok := p.TransportTypes[transport.Type]; ok {
+		return m
+	}
+	if m, ok := p.Receivers[gps.Receiver]; ok {
+		return m
+	}
+	return p.Default
+}
+
+type motionState struct {
+	ref    model.GPS // точка, от которой отсчитывается перемещение для вычисления курса
+	prev   model.GPS // предыдущая точка, от которой вычисляется скорость
+	course uint32
+	speed  uint32
+	known  bool // курс и скорость уже вычислялись
+}
+
+// MotionEstimator вычисляет курс и скорость по соседним точкам каждого UID.
+type MotionEstimator struct {
+	policy   MotionPolicy
+	mu       sync.Mutex
+	vehicles map[string]*motionState
+}
+
+func NewMotionEstimator(policy MotionPolicy) *MotionEstimator {
+	return &MotionEstimator{
+		policy:   policy,
+		vehicles: make(map[string]*motionState),
+	}
+}
+
+// Process заполняет или заменяет курс и скорость в соответствии с режимом для транспорта.
+func (e *MotionEstimator) Process(transport model.Transport, gps model.GPS) model.GPS {
+	mode := e.policy.mode(transport, gps)
+	if mode == MotionOff || mode == "" {
+		return gps
+	}
+	course, speed, ok := e.estimate(gps)
+	if !ok {
+		return gps
+	}
+	if mode == MotionOverride || gps.Course == 0 {
+		gps.Course = course
+	}
+	if mode == MotionOverride || gps.Speed == 0 {
+		gps.Speed = speed
+	}
+	return gps
+}
+
+// Reset забывает предыдущие точки UID, например после переноса трекера на другой транспорт.
+func (e *MotionEstimator) Reset(uid string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.vehicles, uid)
+}
+
+func (e *MotionEstimator) estimate(gps model.GPS) (uint32, uint32, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	state, ok := e.vehicles[gps.UID]
+	if !ok {
+		e.vehicles[gps.UID] = &motionState{ref: gps, prev: gps}
+		return 0, 0, false
+	}
+	if !gps.Time.After(state.prev.Time) {
+		return state.course, state.speed, state.known
+	}
+	gap := gps.Time.Sub(state.prev.Time)
+	prev := state.prev
+	state.prev = gps
+	if e.policy.MaxInterval > 0 && gap > e.policy.MaxInterval {
+		// Перерыв в данных: прежнее направление недостоверно
+		*state = motionState{ref: gps, prev: gps}
+		return 0, 0, false
+	}
+
+	to := geo.Point{Latitude: gps.Latitude, Longitude: gps.Longitude}
+	ref := geo.Point{Latitude: state.ref.Latitude, Longitude: state.ref.Longitude}
+	if geo.Distance(ref, to) < e.policy.MinDistance {
+		// Смещение в пределах шума координат: транспорт стоит, курс сохраняется
+		state.speed = 0
+		return state.course, state.speed, state.known
+	}
+	step := geo.Distance(geo.Point{Latitude: prev.Latitude, Longitude: prev.Longitude}, to)
+	state.speed = uint32(math.Round(step / gap.Seconds() * 3.6))
+	state.course = uint32(math.Round(geo.Bearing(ref, to))) % 360
+	state.ref = gps
+	state.known = true
+	return state.course, state.speed, state.known
+}
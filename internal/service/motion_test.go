@@ -0,0 +1,91 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/model/transport_type"
+)
+
+func TestMotionEstimator(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	bus := model.Transport{StateNumber: "A001AA", Type: transport_type.TypeBUS}
+	tram := model.Transport{StateNumber: "T001", Type: transport_type.TypeTRAMWAY}
+	e := NewMotionEstimator(MotionPolicy{
+		Default:        MotionFill,
+		TransportTypes: map[transport_type.Type]MotionMode{transport_type.TypeTRAMWAY: MotionOff},
+		Receivers:      map[model.Receiver]MotionMode{model.ReceiverEGTS: MotionOverride},
+		MinDistance:    15,
+		MaxInterval:    time.Minute,
+	})
+	point := func(uid string, seconds int, lat, lon float64, speed, course uint32, receiver model.Receiver) model.GPS {
+		return model.GPS{
+			UID:       uid,
+			Time:      start.Add(time.Duration(seconds) * time.Second),
+			Latitude:  lat,
+			Longitude: lon,
+			Speed:     speed,
+			Course:    course,
+			Receiver:  receiver,
+		}
+	}
+
+	t.Run("fill zeros", func(t *testing.T) {
+		gps := e.Process(bus, point("1", 0, 58.600, 49.6, 0, 0, model.ReceiverWialonIPS))
+		require.Zero(t, gps.Course, "first point, nothing to derive from")
+
+		// ~111 м на восток за 10 секунд - 40 км/ч
+		gps = e.Process(bus, point("1", 10, 58.600, 49.6019, 0, 0, model.ReceiverWialonIPS))
+		require.InDelta(t, 90, gps.Course, 1)
+		require.InDelta(t, 40, gps.Speed, 1)
+
+		// Значения трекера сохраняются
+		gps = e.Process(bus, point("1", 20, 58.600, 49.6038, 35, 85, model.ReceiverWialonIPS))
+		require.Equal(t, uint32(85), gps.Course)
+		require.Equal(t, uint32(35), gps.Speed)
+	})
+	t.Run("min distance keeps course", func(t *testing.T) {
+		// Смещение на ~3 м к северу не разворачивает стрелку
+		gps := e.Process(bus, point("1", 30, 58.60003, 49.6038, 0, 0, model.ReceiverWialonIPS))
+		require.InDelta(t, 90, gps.Course, 1)
+		require.Zero(t, gps.Speed)
+	})
+	t.Run("departure after dwell", func(t *testing.T) {
+		e.Process(bus, point("4", 0, 58.600, 49.6, 0, 0, model.ReceiverWialonIPS))
+		// Дрожание координат стоящего транспорта в пределах ~3 м
+		for i, lat := range []float64{58.60002, 58.59998, 58.60003, 58.60001} {
+			gps := e.Process(bus, point("4", 10*(i+1), lat, 49.6, 0, 0, model.ReceiverWialonIPS))
+			require.Zero(t, gps.Speed, "jitter of a parked vehicle")
+		}
+		// ~111 м на восток за 10 секунд после стоянки - 40 км/ч, а не средняя скорость за время стоянки
+		gps := e.Process(bus, point("4", 50, 58.600, 49.6019, 0, 0, model.ReceiverWialonIPS))
+		require.InDelta(t, 90, gps.Course, 1)
+		require.InDelta(t, 40, gps.Speed, 1)
+	})
+	t.Run("override by receiver", func(t *testing.T) {
+		e.Process(bus, point("2", 0, 58.600, 49.6, 30, 0, model.ReceiverEGTS))
+		gps := e.Process(bus, point("2", 10, 58.601, 49.6, 30, 45, model.ReceiverEGTS))
+		require.InDelta(t, 0, gps.Course, 1)
+		require.InDelta(t, 40, gps.Speed, 1)
+	})
+	t.Run("off by transport type", func(t *testing.T) {
+		e.Process(tram, point("3", 0, 58.600, 49.6, 0, 0, model.ReceiverEGTS))
+		gps := e.Process(tram, point("3", 10, 58.601, 49.6, 0, 0, model.ReceiverEGTS))
+		require.Zero(t, gps.Course)
+		require.Zero(t, gps.Speed)
+	})
+	t.Run("idle longer than max interval keeps course", func(t *testing.T) {
+		for seconds := 40; seconds <= 160; seconds += 20 {
+			gps := e.Process(bus, point("1", seconds, 58.60003, 49.6038, 0, 0, model.ReceiverWialonIPS))
+			require.InDelta(t, 90, gps.Course, 1)
+		}
+	})
+	t.Run("reset after gap", func(t *testing.T) {
+		gps := e.Process(bus, point("1", 300, 58.61, 49.6038, 0, 0, model.ReceiverWialonIPS))
+		require.Zero(t, gps.Course)
+		require.Zero(t, gps.Speed)
+	})
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bars43ru/bus2map/internal/model"
+)
+
+// OffRouteRules правила определения схода с маршрута. Событие возникает, если отклонение от линии маршрута
+// превышает Distance дольше Duration, и завершается, если отклонение не превышает ClearDistance дольше ClearDuration.
+// Событие также завершается, если от транспорта нет данных дольше Timeout.
+type OffRouteRules struct {
+	Distance      float64
+	Duration      time.Duration
+	ClearDistance float64
+	ClearDuration time.Duration
+	Timeout       time.Duration
+}
+
+type offRouteState struct {
+	route   model.RouteNumber
+	pending time.Time // начало отклонения или возврата, еще не изменившего состояние
+	active  bool
+	since   time.Time
+	uid     string
+	last    model.GPS // последние данные, по которым определялось состояние
+	offset  float64
+}
+
+// OffRouteDetector определяет сход транспорта с маршрута по расписанию.
+type OffRouteDetector struct {
+	rules    OffRouteRules
+	mu       sync.Mutex
+	vehicles map[model.StateNumber]*offRouteState
+}
+
+func NewOffRouteDetector(rules OffRouteRules) *OffRouteDetector {
+	return &OffRouteDetector{
+		rules:    rules,
+		vehicles: make(map[model.StateNumber]*offRouteState),
+	}
+}
+
+// Process возвращает изменения состояния события схода с маршрута по очередной точке.
+// При смене маршрута по расписанию активное событие завершается.
+func (d *OffRouteDetector) Process(info model.BusTrackingInfo) []model.Alert {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var alerts []model.Alert
+	stateNumber := info.Transport.StateNumber
+	gps := info.Location
+	st, ok := d.vehicles[stateNumber]
+	if ok && st.route != info.Route.Number {
+		if st.active {
+			alerts = append(alerts, d.alert(stateNumber, st, model.AlertCleared, gps, info.Match.Offset))
+		}
+		ok = false
+	}
+	if !info.Match.HasShape {
+		if ok && st.active {
+			alerts = append(alerts, d.alert(stateNumber, st, model.AlertCleared, gps, info.Match.Offset))
+		}
+		delete(d.vehicles, stateNumber)
+		return alerts
+	}
+	if !ok {
+		st = &offRouteState{route: info.Route.Number}
+		d.vehicles[stateNumber] = st
+	}
+	st.uid = gps.UID
+	st.last, st.offset = gps, info.Match.Offset
+
+	// Нарушение условия, которое должно выполняться для изменения состояния, сбрасывает ожидание.
+	changing := info.Match.Offset > d.rules.Distance
+	duration := d.rules.Duration
+	if st.active {
+		changing = info.Match.Offset <= d.rules.ClearDistance
+		duration = d.rules.ClearDuration
+	}
+	if !changing {
+		st.pending = time.Time{}
+		return alerts
+	}
+	if st.pending.IsZero() {
+		st.pending = gps.Time
+	}
+	if gps.Time.Sub(st.pending) < duration {
+		return alerts
+	}
+
+	if st.active {
+		alerts = append(alerts, d.alert(stateNumber, st, model.AlertCleared, gps, info.Match.Offset))
+		st.active = false
+	} else {
+		st.active = true
+		st.since = st.pending
+		alerts = append(alerts, d.alert(stateNumber, st, model.AlertRaised, gps, info.Match.Offset))
+	}
+	st.pending = time.Time{}
+	return alerts
+}
+
+// Clear сбрасывает состояние транспорта, данные от которого больше не проверяются на сход с маршрута
+// (нет расписания или маршрута, публикация подавлена геозоной), и завершает активное событие.
+func (d *OffRouteDetector) Clear(stateNumber model.StateNumber, gps model.GPS) []model.Alert {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	st, ok := d.vehicles[stateNumber]
+	if !ok {
+		return nil
+	}
+	delete(d.vehicles, stateNumber)
+	if !st.active {
+		return nil
+	}
+	return []model.Alert{d.alert(stateNumber, st, model.AlertCleared, gps, st.offset)}
+}
+
+// Sweep сбрасывает состояние транспорта, от которого нет данных дольше Timeout, и завершает активные события.
+func (d *OffRouteDetector) Sweep(now time.Time) []model.Alert {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var alerts []model.Alert
+	for stateNumber, st := range d.vehicles {
+		if now.Sub(st.last.Time) <= d.rules.Timeout {
+			continue
+		}
+		delete(d.vehicles, stateNumber)
+		if st.active {
+			alert := d.alert(stateNumber, st, model.AlertCleared, st.last, st.offset)
+			alert.Time = now
+			alerts = append(alerts, alert)
+		}
+	}
+	return alerts
+}
+
+func (d *OffRouteDetector) alert(
+	stateNumber model.StateNumber,
+	st *offRouteState,
+	state model.AlertState,
+	gps model.GPS,
+	offset float64,
+) model.Alert {
+	return model.Alert{
+		Kind:        model.AlertOffRoute,
+		State:       state,
+		StateNumber: stateNumber,
+		UID:         st.uid,
+		RouteNumber: st.route,
+		Since:       st.since,
+		Time:        gps.Time,
+		Location:    gps,
+		Offset:      offset,
+	}
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+)
+
+func TestOffRouteDetector(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	d := NewOffRouteDetector(OffRouteRules{
+		Distance:      100,
+		Duration:      time.Minute,
+		ClearDistance: 30,
+		ClearDuration: 20 * time.Second,
+		Timeout:       10 * time.Minute,
+	})
+	point := func(seconds int, route model.RouteNumber, offset float64) model.BusTrackingInfo {
+		return model.BusTrackingInfo{
+			Route:     model.Route{Number: route},
+			Transport: model.Transport{StateNumber: "A001AA"},
+			Location:  model.GPS{UID: "1", Time: start.Add(time.Duration(seconds) * time.Second)},
+			Match:     model.RouteMatch{HasShape: true, Offset: offset},
+		}
+	}
+
+	require.Empty(t, d.Process(point(0, "1", 10)))
+	require.Empty(t, d.Process(point(10, "1", 200)))
+	require.Empty(t, d.Process(point(40, "1", 50)), "short deviation resets waiting")
+	require.Empty(t, d.Process(point(50, "1", 200)))
+	require.Empty(t, d.Process(point(100, "1", 200)))
+
+	alerts := d.Process(point(110, "1", 250))
+	require.Len(t, alerts, 1)
+	require.Equal(t, model.AlertRaised, alerts[0].State)
+	require.Equal(t, model.AlertOffRoute, alerts[0].Kind)
+	require.Equal(t, start.Add(50*time.Second), alerts[0].Since)
+	require.Equal(t, 250.0, alerts[0].Offset)
+
+	require.Empty(t, d.Process(point(120, "1", 300)), "alert is raised once")
+	require.Empty(t, d.Process(point(130, "1", 60)), "hysteresis: not yet within clear distance")
+	require.Empty(t, d.Process(point(140, "1", 20)))
+
+	alerts = d.Process(point(160, "1", 10))
+	require.Len(t, alerts, 1)
+	require.Equal(t, model.AlertCleared, alerts[0].State)
+	require.Equal(t, start.Add(50*time.Second), alerts[0].Since)
+
+	t.Run("schedule route change clears alert", func(t *testing.T) {
+		d.Process(point(200, "1", 500))
+		require.Len(t, d.Process(point(300, "1", 500)), 1)
+		alerts := d.Process(point(310, "2", 500))
+		require.Len(t, alerts, 1)
+		require.Equal(t, model.AlertCleared, alerts[0].State)
+		require.Equal(t, model.RouteNumber("1"), alerts[0].RouteNumber)
+	})
+	raise := func(seconds int) {
+		t.Helper()
+		d.Clear("A001AA", model.GPS{})
+		d.Process(point(seconds, "2", 500))
+		alerts := d.Process(point(seconds+100, "2", 500))
+		require.Len(t, alerts, 1)
+		require.Equal(t, model.AlertRaised, alerts[0].State)
+	}
+
+	t.Run("lost shape clears alert", func(t *testing.T) {
+		raise(400)
+		info := point(510, "2", 0)
+		info.Match.HasShape = false
+		alerts := d.Process(info)
+		require.Len(t, alerts, 1)
+		require.Equal(t, model.AlertCleared, alerts[0].State)
+	})
+
+	t.Run("dropped vehicle clears alert", func(t *testing.T) {
+		raise(600)
+		gps := model.GPS{UID: "1", Time: start.Add(710 * time.Second)}
+		alerts := d.Clear("A001AA", gps)
+		require.Len(t, alerts, 1)
+		require.Equal(t, model.AlertCleared, alerts[0].State)
+		require.Equal(t, 500.0, alerts[0].Offset)
+		require.Empty(t, d.Clear("A001AA", gps), "state is removed")
+	})
+
+	t.Run("silent vehicle clears alert", func(t *testing.T) {
+		raise(800)
+		require.Empty(t, d.Sweep(start.Add(900*time.Second+10*time.Minute)))
+		now := start.Add(901*time.Second + 10*time.Minute)
+		alerts := d.Sweep(now)
+		require.Len(t, alerts, 1)
+		require.Equal(t, model.AlertCleared, alerts[0].State)
+		require.Equal(t, now, alerts[0].Time)
+		require.Equal(t, start.Add(900*time.Second), alerts[0].Location.Time)
+		require.Empty(t, d.Sweep(now), "state is removed")
+	})
+}
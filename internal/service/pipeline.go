@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/pkg/xslog"
+)
+
+// pipelineStats счетчики стадий конвейера обработки GPS-данных, доступно по /debug/vars
+var pipelineStats = expvar.NewMap("gps_pipeline")
+
+// Stage стадия конвейера обработки GPS-данных. Стадия дополняет данные point, отбрасывает их, возвращая
+// ошибку Drop с причиной, или порождает дополнительные данные forks, которые проходят последующие стадии
+// независимо от point. Любая другая ошибка также отбрасывает данные и учитывается как сбой стадии.
+type Stage interface {
+	Name() string
+	Process(ctx context.Context, point *model.BusTrackingInfo) (forks []*model.BusTrackingInfo, err error)
+}
+
+// StageFunc функция обработки данных стадией конвейера
+type StageFunc func(ctx context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error)
+
+type stage struct {
+	name string
+	fn   StageFunc
+}
+
+// NewStage создает стадию конвейера с именем name из функции fn.
+func NewStage(name string, fn StageFunc) Stage {
+	return &stage{name: name, fn: fn}
+}
+
+func (s *stage) Name() string {
+	return s.name
+}
+
+func (s *stage) Process(ctx context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+	return s.fn(ctx, point)
+}
+
+// DropError ошибка, которой стадия отбрасывает данные без признака сбоя.
+type DropError struct {
+	Reason string
+}
+
+func (e *DropError) Error() string {
+	return fmt.Sprintf("gps data dropped: %s", e.Reason)
+}
+
+// Drop возвращает ошибку отбрасывания данных с причиной reason.
+func Drop(reason string) error {
+	return &DropError{Reason: reason}
+}
+
+// stageMetrics счетчики стадии: поступившие, пропущенные дальше, порожденные, отброшенные
+// в разрезе причин и завершившиеся сбоем данные, а также суммарное время обработки.
+type stageMetrics struct {
+	in       *expvar.Int
+	passed   *expvar.Int
+	forked   *expvar.Int
+	dropped  *expvar.Map
+	errors   *expvar.Int
+	duration *expvar.Int
+}
+
+// newStageMetrics возвращает счетчики стадии name. Стадии с одинаковыми именами в разных конвейерах
+// используют общие счетчики.
+func newStageMetrics(name string) stageMetrics {
+	m := statsVar(pipelineStats, name, func() *expvar.Map { return new(expvar.Map) })
+	newInt := func() *expvar.Int { return new(expvar.Int) }
+	return stageMetrics{
+		in:       statsVar(m, "in", newInt),
+		passed:   statsVar(m, "passed", newInt),
+		forked:   statsVar(m, "forked", newInt),
+		dropped:  statsVar(m, "dropped", func() *expvar.Map { return new(expvar.Map) }),
+		errors:   statsVar(m, "errors", newInt),
+		duration: statsVar(m, "duration_ns", newInt),
+	}
+}
+
+// statsVar возвращает значение key из m, добавляя новое, если его нет.
+func statsVar[T expvar.Var](m *expvar.Map, key string, init func() T) T {
+	if v, ok := m.Get(key).(T); ok {
+		return v
+	}
+	v := init()
+	m.Set(key, v)
+	return v
+}
+
+type pipelineStage struct {
+	Stage
+	metrics stageMetrics
+}
+
+// Pipeline упорядоченный набор стадий обработки GPS-данных.
+type Pipeline struct {
+	stages []pipelineStage
+}
+
+// NewPipeline создает конвейер из стадий в порядке их выполнения. Имена стадий должны быть уникальны.
+func NewPipeline(stages ...Stage) (*Pipeline, error) {
+	p := &Pipeline{stages: make([]pipelineStage, 0, len(stages))}
+	names := make(map[string]struct{}, len(stages))
+	for _, s := range stages {
+		if _, ok := names[s.Name()]; ok {
+			return nil, fmt.Errorf("duplicate pipeline stage %q", s.Name())
+		}
+		names[s.Name()] = struct{}{}
+		p.stages = append(p.stages, pipelineStage{Stage: s, metrics: newStageMetrics(s.Name())})
+	}
+	return p, nil
+}
+
+// Stages возвращает имена стадий в порядке выполнения.
+func (p *Pipeline) Stages() []string {
+	names := make([]string, 0, len(p.stages))
+	for _, s := range p.stages {
+		names = append(names, s.Name())
+	}
+	return names
+}
+
+// Process пропускает данные через все стадии и возвращает данные, прошедшие конвейер:
+// исходные, если они не отброшены, и порожденные стадиями.
+func (p *Pipeline) Process(ctx context.Context, point *model.BusTrackingInfo) []*model.BusTrackingInfo {
+	return p.process(ctx, 0, point)
+}
+
+func (p *Pipeline) process(ctx context.Context, from int, point *model.BusTrackingInfo) []*model.BusTrackingInfo {
+	type fork struct {
+		next  int
+		point *model.BusTrackingInfo
+	}
+	var (
+		forks   []fork
+		dropped bool
+	)
+	for i := from; i < len(p.stages) && !dropped; i++ {
+		s := p.stages[i]
+		s.metrics.in.Add(1)
+		start := time.Now()
+		items, err := s.Process(ctx, point)
+		s.metrics.duration.Add(int64(time.Since(start)))
+		s.metrics.forked.Add(int64(len(items)))
+		for _, item := range items {
+			forks = append(forks, fork{next: i + 1, point: item})
+		}
+		var drop *DropError
+		switch {
+		case err == nil:
+			s.metrics.passed.Add(1)
+		case errors.As(err, &drop):
+			s.metrics.dropped.Add(drop.Reason, 1)
+			dropped = true
+			slog.DebugContext(ctx, "gps data dropped",
+				slog.String("stage", s.Name()),
+				slog.String("uid", point.Location.UID),
+				slog.String("reason", drop.Reason),
+			)
+		default:
+			s.metrics.errors.Add(1)
+			dropped = true
+			slog.ErrorContext(ctx, "process gps data",
+				slog.String("stage", s.Name()),
+				slog.String("uid", point.Location.UID),
+				xslog.Error(err),
+			)
+		}
+	}
+	var result []*model.BusTrackingInfo
+	if !dropped {
+		result = append(result, point)
+	}
+	for _, f := range forks {
+		result = append(result, p.process(ctx, f.next, f.point)...)
+	}
+	return result
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+)
+
+func TestPipeline(t *testing.T) {
+	ctx := context.Background()
+	var trace []string
+	enrich := NewStage("test_enrich", func(_ context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+		trace = append(trace, "enrich "+point.Location.UID)
+		point.Transport.StateNumber = model.StateNumber("SN-" + point.Location.UID)
+		return nil, nil
+	})
+	fork := NewStage("test_fork", func(_ context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+		trace = append(trace, "fork "+point.Location.UID)
+		if point.Location.UID != "fork" {
+			return nil, nil
+		}
+		twin := *point
+		twin.Location.UID = "twin"
+		return []*model.BusTrackingInfo{&twin}, nil
+	})
+	filter := NewStage("test_filter", func(_ context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+		trace = append(trace, "filter "+point.Location.UID)
+		switch point.Location.UID {
+		case "drop":
+			return nil, Drop("test")
+		case "fail":
+			return nil, errors.New("failure")
+		}
+		return nil, nil
+	})
+	p, err := NewPipeline(enrich, fork, filter)
+	require.NoError(t, err)
+	require.Equal(t, []string{"test_enrich", "test_fork", "test_filter"}, p.Stages())
+
+	process := func(uid string) []string {
+		var uids []string
+		for _, point := range p.Process(ctx, &model.BusTrackingInfo{Location: model.GPS{UID: uid}}) {
+			uids = append(uids, point.Location.UID+" "+point.Transport.StateNumber.String())
+		}
+		return uids
+	}
+	require.Equal(t, []string{"pass SN-pass"}, process("pass"))
+	require.Empty(t, process("drop"))
+	require.Empty(t, process("fail"))
+
+	trace = nil
+	require.Equal(t, []string{"fork SN-fork", "twin SN-fork"}, process("fork"))
+	require.Equal(t, []string{"enrich fork", "fork fork", "filter fork", "filter twin"}, trace,
+		"forked point continues from the next stage")
+
+	stats := func(stage, key string) string {
+		return pipelineStats.Get(stage).(*expvar.Map).Get(key).String()
+	}
+	require.Equal(t, "4", stats("test_enrich", "in"))
+	require.Equal(t, "1", stats("test_fork", "forked"))
+	require.Equal(t, "5", stats("test_filter", "in"))
+	require.Equal(t, "3", stats("test_filter", "passed"))
+	require.Equal(t, "1", stats("test_filter", "errors"))
+	require.Equal(t, `{"test": 1}`, stats("test_filter", "dropped"))
+
+	_, err = NewPipeline(enrich, enrich)
+	require.Error(t, err)
+}
+
+func TestBusTracking_NewPipeline(t *testing.T) {
+	s := New(Options{})
+	custom := NewStage("custom", func(context.Context, *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+		return nil, nil
+	})
+
+	p, err := s.NewPipeline(nil, custom)
+	require.NoError(t, err)
+	require.Equal(t, []string{StageTransport, StageSchedule, StageRoute, "custom"}, p.Stages())
+
+	p, err = s.NewPipeline([]string{"custom", StageTransport, StageValidate, StageSchedule, StageRoute}, custom)
+	require.NoError(t, err)
+	require.Equal(t, []string{"custom", StageTransport, StageSchedule, StageRoute}, p.Stages(),
+		"disabled stages are skipped")
+
+	_, err = s.NewPipeline([]string{StageTransport, StageSchedule, StageRoute, "unknown"})
+	require.Error(t, err)
+	_, err = s.NewPipeline([]string{StageTransport, StageRoute})
+	require.Error(t, err)
+	_, err = s.NewPipeline([]string{StageSchedule, StageTransport, StageRoute})
+	require.Error(t, err)
+
+	s = New(Options{
+		Motion:   NewMotionEstimator(MotionPolicy{}),
+		OffRoute: NewOffRouteDetector(OffRouteRules{}),
+	})
+	p, err = s.NewPipeline([]string{
+		StageBinding, StageTransport, StageMotion, StageSchedule, StageRoute, StageMatch, StageOffRoute,
+	})
+	require.NoError(t, err, "dependencies of disabled stages are not checked")
+	require.Equal(t, []string{StageBinding, StageTransport, StageMotion, StageSchedule, StageRoute, StageOffRoute},
+		p.Stages())
+	_, err = s.NewPipeline([]string{StageBinding, StageMotion, StageTransport, StageSchedule, StageRoute})
+	require.ErrorContains(t, err, `"motion" must follow "transport"`)
+	_, err = s.NewPipeline([]string{StageTransport, StageMotion, StageSchedule, StageRoute})
+	require.ErrorContains(t, err, `"motion" must follow "binding"`, "tracker move reset can't be skipped")
+	_, err = s.NewPipeline([]string{StageTransport, StageSchedule, StageOffRoute, StageRoute})
+	require.ErrorContains(t, err, `"off_route" must follow "route"`)
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+)
+
+// ScheduleRules допуски при определении расписания транспорта по времени GPS-данных.
+type ScheduleRules struct {
+	PreStart       time.Duration // допуск до начала смены
+	PostEnd        time.Duration // допуск после окончания смены
+	LastAssignment time.Duration // время использования последнего известного расписания, 0 - не используется
+}
+
+type lastAssignment struct {
+	schedule model.Schedule
+	time     time.Time // время GPS-данных, для которых расписание определено
+}
+
+// ScheduleResolver определяет расписание транспорта с учетом допусков до начала и после окончания смены.
+// Если расписание не найдено, используется закрепленный за транспортом маршрут, а при его отсутствии
+// в течение ограниченного времени - последнее известное расписание.
+type ScheduleResolver struct {
+	schedule *repository.Schedule
+	defaults *repository.DefaultRoute // nil, если закрепленные маршруты не используются
+	rules    ScheduleRules
+	mu       sync.Mutex
+	last     map[model.StateNumber]lastAssignment
+}
+
+func NewScheduleResolver(
+	schedule *repository.Schedule,
+	defaults *repository.DefaultRoute,
+	rules ScheduleRules,
+) *ScheduleResolver {
+	return &ScheduleResolver{
+		schedule: schedule,
+		defaults: defaults,
+		rules:    rules,
+		last:     make(map[model.StateNumber]lastAssignment),
+	}
+}
+
+// Resolve возвращает расписание транспорта на момент currentTime и способ, которым оно определено.
+// Если расписание не найдено, возвращается repository.ErrNotFound.
+func (r *ScheduleResolver) Resolve(
+	stateNumber model.StateNumber,
+	currentTime time.Time,
+) (model.Schedule, model.ScheduleMatch, error) {
+	schedule, err := r.schedule.GetNearest(stateNumber, currentTime, r.rules.PreStart, r.rules.PostEnd)
+	if err == nil {
+		match := model.ScheduleExact
+		switch {
+		case currentTime.Before(schedule.From):
+			match = model.SchedulePreStart
+		case currentTime.After(schedule.To):
+			match = model.SchedulePostEnd
+		}
+		r.remember(schedule, currentTime)
+		return schedule, match, nil
+	}
+	if !errors.Is(err, repository.ErrNotFound) {
+		return model.Schedule{}, "", err
+	}
+	if r.defaults != nil {
+		schedule, err = r.defaults.GetCurrent(stateNumber, currentTime)
+		if err == nil {
+			return schedule, model.ScheduleDefaultRoute, nil
+		}
+		if !errors.Is(err, repository.ErrNotFound) {
+			return model.Schedule{}, "", err
+		}
+	}
+	if r.rules.LastAssignment <= 0 {
+		return model.Schedule{}, "", repository.ErrNotFound
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	last, ok := r.last[stateNumber]
+	if !ok || currentTime.Before(last.time) || currentTime.Sub(last.time) > r.rules.LastAssignment {
+		return model.Schedule{}, "", repository.ErrNotFound
+	}
+	return last.schedule, model.ScheduleLastAssignment, nil
+}
+
+func (r *ScheduleResolver) remember(schedule model.Schedule, currentTime time.Time) {
+	if r.rules.LastAssignment <= 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if last, ok := r.last[schedule.StateNumber]; ok && last.time.After(currentTime) {
+		return
+	}
+	r.last[schedule.StateNumber] = lastAssignment{schedule: schedule, time: currentTime}
+}
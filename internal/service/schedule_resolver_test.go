@@ -0,0 +1,63 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+)
+
+func TestScheduleResolver(t *testing.T) {
+	start := time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC)
+	schedule := repository.NewSchedule("", nil)
+	schedule.Replace([]model.Schedule{
+		{Number: "1", StateNumber: "A001AA", From: start, To: start.Add(2 * time.Hour)},
+		{Number: "2", StateNumber: "A001AA", From: start.Add(2*time.Hour + 10*time.Minute), To: start.Add(4 * time.Hour)},
+	})
+	r := NewScheduleResolver(schedule, nil, ScheduleRules{
+		PreStart:       15 * time.Minute,
+		PostEnd:        15 * time.Minute,
+		LastAssignment: 30 * time.Minute,
+	})
+	at := func(minutes int) time.Time {
+		return start.Add(time.Duration(minutes) * time.Minute)
+	}
+	resolve := func(minutes int) (model.RouteNumber, model.ScheduleMatch) {
+		s, match, err := r.Resolve("A001AA", at(minutes))
+		require.NoError(t, err, "minute %d", minutes)
+		return s.Number, match
+	}
+
+	_, _, err := r.Resolve("A001AA", at(-20))
+	require.ErrorIs(t, err, repository.ErrNotFound, "before pre-start tolerance and no last assignment")
+
+	number, match := resolve(-10)
+	require.Equal(t, model.RouteNumber("1"), number)
+	require.Equal(t, model.SchedulePreStart, match)
+
+	number, match = resolve(60)
+	require.Equal(t, model.RouteNumber("1"), number)
+	require.Equal(t, model.ScheduleExact, match)
+
+	number, match = resolve(124)
+	require.Equal(t, model.RouteNumber("1"), number, "the nearest shift wins")
+	require.Equal(t, model.SchedulePostEnd, match)
+
+	number, match = resolve(127)
+	require.Equal(t, model.RouteNumber("2"), number)
+	require.Equal(t, model.SchedulePreStart, match)
+
+	number, match = resolve(250)
+	require.Equal(t, model.RouteNumber("2"), number)
+	require.Equal(t, model.SchedulePostEnd, match)
+
+	number, match = resolve(270)
+	require.Equal(t, model.RouteNumber("2"), number)
+	require.Equal(t, model.ScheduleLastAssignment, match)
+
+	_, _, err = r.Resolve("A001AA", at(290))
+	require.ErrorIs(t, err, repository.ErrNotFound, "last assignment expired")
+}
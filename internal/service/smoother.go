@@ -0,0 +1,174 @@
+package service
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/pkg/geo"
+)
+
+// SmoothingRules параметры подавления дрожания координат на стоянке и сглаживания трека.
+type SmoothingRules struct {
+	StationarySpeed  uint32        // скорость, не превышая которую транспорт может считаться стоящим
+	StationaryRadius float64       // радиус в метрах, в пределах которого дрейфуют координаты стоящего транспорта
+	StationaryWindow time.Duration // время, после которого транспорт в пределах радиуса считается стоящим
+	Kalman           bool          // сглаживать трек движущегося транспорта фильтром Калмана
+	KalmanAccuracy   float64       // погрешность координат трекера в метрах
+	KalmanNoise      float64       // ожидаемое изменение скорости в м/с за секунду, определяющее доверие новым координатам
+	ResetAfter       time.Duration // при перерыве в данных дольше этого времени состояние сбрасывается
+}
+
+type smoothState struct {
+	last time.Time
+
+	// стоянка
+	center   geo.Point // средние координаты точек в пределах радиуса
+	count    int
+	since    time.Time
+	pinned   bool
+	position geo.Point // закрепленные координаты стоящего транспорта
+
+	// фильтр Калмана
+	tracking   bool // оценка положения и скорости есть
+	estimate   geo.Point
+	velocity   [2]float64    // скорость на север и на восток в м/с
+	covariance [2][2]float64 // ковариация положения (м) и скорости (м/с), общая для обеих осей
+}
+
+// Smoother подавляет дрожание координат стоящего транспорта и сглаживает трек движущегося.
+// Состояние хранится отдельно для каждого UID.
+type Smoother struct {
+	rules    SmoothingRules
+	mu       sync.Mutex
+	vehicles map[string]*smoothState
+}
+
+func NewSmoother(rules SmoothingRules) *Smoother {
+	return &Smoother{
+		rules:    rules,
+		vehicles: make(map[string]*smoothState),
+	}
+}
+
+// Process возвращает GPS-данные с закрепленными координатами на стоянке или сглаженными в движении.
+// Точки, пришедшие не по порядку, возвращаются без изменений.
+func (s *Smoother) Process(gps model.GPS) model.GPS {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	point := geo.Point{Latitude: gps.Latitude, Longitude: gps.Longitude}
+	state, ok := s.vehicles[gps.UID]
+	if !ok || (s.rules.ResetAfter > 0 && gps.Time.Sub(state.last) > s.rules.ResetAfter) {
+		state = &smoothState{}
+		state.resetStationary(point, gps.Time)
+		s.vehicles[gps.UID] = state
+	}
+	if gps.Time.Before(state.last) {
+		return gps
+	}
+	dt := gps.Time.Sub(state.last)
+	state.last = gps.Time
+
+	if s.stationary(state, point, gps) {
+		state.tracking = true
+		state.estimate = state.position
+		state.velocity = [2]float64{}
+		state.covariance = [2][2]float64{{math.Pow(s.rules.KalmanAccuracy, 2), 0}, {0, 0}}
+		gps.Latitude = state.position.Latitude
+		gps.Longitude = state.position.Longitude
+		gps.Speed = 0
+		return gps
+	}
+
+	if s.rules.Kalman {
+		point = s.kalman(state, point, dt)
+		gps.Latitude = point.Latitude
+		gps.Longitude = point.Longitude
+	}
+	return gps
+}
+
+// Reset сбрасывает состояние сглаживания UID, например после переноса трекера на другой транспорт.
+func (s *Smoother) Reset(uid string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.vehicles, uid)
+}
+
+func (s *Smoother) stationary(state *smoothState, point geo.Point, gps model.GPS) bool {
+	if s.rules.StationaryWindow <= 0 {
+		return false
+	}
+	if gps.Speed > s.rules.StationarySpeed || geo.Distance(state.center, point) > s.rules.StationaryRadius {
+		state.resetStationary(point, gps.Time)
+		return false
+	}
+	if state.pinned {
+		return true
+	}
+	state.count++
+	state.center.Latitude += (point.Latitude - state.center.Latitude) / float64(state.count)
+	state.center.Longitude += (point.Longitude - state.center.Longitude) / float64(state.count)
+	if gps.Time.Sub(state.since) >= s.rules.StationaryWindow {
+		state.pinned = true
+		state.position = state.center
+	}
+	return state.pinned
+}
+
+func (state *smoothState) resetStationary(point geo.Point, at time.Time) {
+	state.center = point
+	state.count = 1
+	state.since = at
+	state.pinned = false
+}
+
+// kalmanInitialSpeed неопределенность скорости в м/с в начале отслеживания
+const kalmanInitialSpeed = 20
+
+// kalman фильтр Калмана с моделью постоянной скорости; широта и долгота фильтруются независимо
+// в метрах с общей ковариацией, изменение скорости моделируется случайным ускорением KalmanNoise м/с².
+func (s *Smoother) kalman(state *smoothState, point geo.Point, dt time.Duration) geo.Point {
+	accuracy := math.Pow(max(s.rules.KalmanAccuracy, 1), 2)
+	if !state.tracking {
+		state.tracking = true
+		state.estimate = point
+		state.velocity = [2]float64{}
+		state.covariance = [2][2]float64{{accuracy, 0}, {0, kalmanInitialSpeed * kalmanInitialSpeed}}
+		return point
+	}
+	// метров в градусе широты и долготы
+	scaleLat := geo.EarthRadius * math.Pi / 180
+	scaleLon := scaleLat * math.Cos(state.estimate.Latitude*math.Pi/180)
+
+	// прогноз
+	t := dt.Seconds()
+	state.estimate.Latitude += state.velocity[0] * t / scaleLat
+	state.estimate.Longitude += state.velocity[1] * t / scaleLon
+	p := state.covariance
+	q := s.rules.KalmanNoise * s.rules.KalmanNoise
+	p = [2][2]float64{
+		{p[0][0] + t*(p[0][1]+p[1][0]) + t*t*p[1][1] + q*t*t*t*t/4, p[0][1] + t*p[1][1] + q*t*t*t/2},
+		{p[1][0] + t*p[1][1] + q*t*t*t/2, p[1][1] + q*t*t},
+	}
+
+	// коррекция по измерению
+	kPosition := p[0][0] / (p[0][0] + accuracy)
+	kVelocity := p[1][0] / (p[0][0] + accuracy)
+	residual := [2]float64{
+		(point.Latitude - state.estimate.Latitude) * scaleLat,
+		(point.Longitude - state.estimate.Longitude) * scaleLon,
+	}
+	state.estimate.Latitude += kPosition * residual[0] / scaleLat
+	state.estimate.Longitude += kPosition * residual[1] / scaleLon
+	for i := range state.velocity {
+		state.velocity[i] += kVelocity * residual[i]
+	}
+	state.covariance = [2][2]float64{
+		{(1 - kPosition) * p[0][0], (1 - kPosition) * p[0][1]},
+		{p[1][0] - kVelocity*p[0][0], p[1][1] - kVelocity*p[0][1]},
+	}
+	return state.estimate
+}
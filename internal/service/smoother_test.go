@@ -0,0 +1,82 @@
+package service
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/pkg/geo"
+)
+
+func TestSmootherStationary(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := NewSmoother(SmoothingRules{
+		StationarySpeed:  3,
+		StationaryRadius: 30,
+		StationaryWindow: time.Minute,
+	})
+	// Дрейф координат в пределах ~10 метров с ненулевой скоростью
+	drift := []struct{ lat, lon float64 }{
+		{58.60000, 49.60000},
+		{58.60005, 49.60010},
+		{58.59995, 49.59990},
+		{58.60008, 49.59995},
+		{58.59997, 49.60012},
+	}
+	var pinned model.GPS
+	for i, d := range drift {
+		gps := s.Process(model.GPS{
+			UID:       "1",
+			Time:      start.Add(time.Duration(i) * 30 * time.Second),
+			Latitude:  d.lat,
+			Longitude: d.lon,
+			Speed:     2,
+		})
+		if i < 2 {
+			require.Equal(t, d.lat, gps.Latitude, "not yet stationary")
+			continue
+		}
+		require.Zero(t, gps.Speed)
+		if i == 2 {
+			pinned = gps
+			continue
+		}
+		require.Equal(t, pinned.Latitude, gps.Latitude)
+		require.Equal(t, pinned.Longitude, gps.Longitude)
+	}
+
+	// Начало движения снимает закрепление
+	gps := s.Process(model.GPS{UID: "1", Time: start.Add(3 * time.Minute), Latitude: 58.601, Longitude: 49.60, Speed: 20})
+	require.Equal(t, 58.601, gps.Latitude)
+	require.Equal(t, uint32(20), gps.Speed)
+}
+
+func TestSmootherKalman(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := NewSmoother(SmoothingRules{
+		Kalman:         true,
+		KalmanAccuracy: 15,
+		KalmanNoise:    0.5,
+	})
+	first := s.Process(model.GPS{UID: "1", Time: start, Latitude: 58.6, Longitude: 49.6, Speed: 30})
+	require.Equal(t, 58.6, first.Latitude)
+
+	// Транспорт движется на север со скоростью 10 м/с, координаты с шумом до 15 метров
+	const metersPerDegree = geo.EarthRadius * math.Pi / 180
+	noise := []float64{12, -15, 8, -5, 14, -12, 3, -9, 15, -14, 6, -2, 11, -8, 0, 13, -15, 7, -4, 10}
+	for i, n := range noise {
+		at := start.Add(time.Duration(i+1) * 5 * time.Second)
+		truth := 58.6 + float64(i+1)*5*10/metersPerDegree
+		gps := s.Process(model.GPS{UID: "1", Time: at, Latitude: truth + n/metersPerDegree, Longitude: 49.6, Speed: 36})
+		if i >= 10 {
+			require.InDelta(t, truth, gps.Latitude, 10/metersPerDegree, "estimate follows the moving vehicle without lag")
+		}
+	}
+
+	// Точка не по порядку не меняется
+	old := s.Process(model.GPS{UID: "1", Time: start.Add(-time.Second), Latitude: 58.7, Longitude: 49.7})
+	require.Equal(t, 58.7, old.Latitude)
+}
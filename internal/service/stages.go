@@ -0,0 +1,367 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+	"github.com/bars43ru/bus2map/pkg/xslog"
+)
+
+// Имена встроенных стадий конвейера обработки GPS-данных
+const (
+	StageNamespace = "namespace" // преобразование UID в пространство имен приемника
+	StageClock     = "clock"     // исправление расхождения часов трекера
+	StageBinding   = "binding"   // определение привязки трекера и сброс состояния при переносе на другой транспорт
+	StageValidate  = "validate"  // проверка достоверности данных
+	StageSmooth    = "smooth"    // подавление дрожания и сглаживание трека
+	StageTransport = "transport" // определение транспорта по UID
+	StageTrackers  = "trackers"  // выбор активного трекера транспорта
+	StageMotion    = "motion"    // вычисление курса и скорости
+	StageSchedule  = "schedule"  // определение расписания транспорта
+	StageRoute     = "route"     // определение маршрута по расписанию
+	StageMatch     = "match"     // привязка к геометрии маршрута
+	StageGeofence  = "geofence"  // определение геозон и подавление публикации
+	StageOffRoute  = "off_route" // выявление схода с маршрута
+	StageHeadway   = "headway"   // контроль интервалов движения
+	StageStops     = "stops"     // отслеживание остановок
+	StageAdherence = "adherence" // соблюдение расписания по остановкам
+)
+
+// Причины отбрасывания данных встроенными стадиями
+const (
+	DropUnknownUID = "unknown_uid"
+	DropNoSchedule = "no_schedule"
+	DropNoRoute    = "no_route"
+)
+
+// requiredStages встроенные стадии, без которых данные нельзя опубликовать
+var requiredStages = []string{StageTransport, StageSchedule, StageRoute}
+
+// stageDependencies встроенные стадии, результат которых использует стадия; включенные стадии
+// из зависимостей должны выполняться раньше нее
+var stageDependencies = map[string][]string{
+	StageBinding:   {StageNamespace, StageClock},
+	StageValidate:  {StageBinding},
+	StageSmooth:    {StageBinding},
+	StageTransport: {StageNamespace},
+	StageTrackers:  {StageTransport},
+	StageMotion:    {StageTransport, StageBinding},
+	StageSchedule:  {StageTransport},
+	StageRoute:     {StageSchedule},
+	StageMatch:     {StageRoute},
+	StageOffRoute:  {StageRoute, StageMatch},
+	StageHeadway:   {StageRoute, StageMatch},
+	StageStops:     {StageRoute},
+	StageAdherence: {StageStops},
+}
+
+// BuiltinStages возвращает включенные встроенные стадии в порядке выполнения по умолчанию.
+func (s *BusTracking) BuiltinStages() []Stage {
+	var stages []Stage
+	if s.uids != nil {
+		stages = append(stages, NewStage(StageNamespace, s.namespaceStage))
+	}
+	if s.clock != nil {
+		stages = append(stages, NewStage(StageClock, s.clockStage))
+	}
+	if s.validator != nil || s.smoother != nil || s.motion != nil {
+		stages = append(stages, NewStage(StageBinding, s.bindingStage))
+	}
+	if s.validator != nil {
+		stages = append(stages, NewStage(StageValidate, s.validateStage))
+	}
+	if s.smoother != nil {
+		stages = append(stages, NewStage(StageSmooth, s.smoothStage))
+	}
+	stages = append(stages, NewStage(StageTransport, s.transportStage))
+	if s.merger != nil {
+		stages = append(stages, NewStage(StageTrackers, s.trackersStage))
+	}
+	if s.motion != nil {
+		stages = append(stages, NewStage(StageMotion, s.motionStage))
+	}
+	stages = append(stages,
+		NewStage(StageSchedule, s.scheduleStage),
+		NewStage(StageRoute, s.routeStage),
+	)
+	if s.matcher != nil {
+		stages = append(stages, NewStage(StageMatch, s.matchStage))
+	}
+	if s.geofence != nil {
+		stages = append(stages, NewStage(StageGeofence, s.geofenceStage))
+	}
+	if s.offRoute != nil {
+		stages = append(stages, NewStage(StageOffRoute, s.offRouteStage))
+	}
+	if s.headway != nil {
+		stages = append(stages, NewStage(StageHeadway, s.headwayStage))
+	}
+	if s.stops != nil {
+		stages = append(stages, NewStage(StageStops, s.stopsStage))
+		if s.adherence != nil {
+			stages = append(stages, NewStage(StageAdherence, s.adherenceStage))
+		}
+	}
+	return stages
+}
+
+// NewPipeline создает конвейер из встроенных стадий и стадий custom в порядке order.
+// Если order пуст, встроенные стадии выполняются в порядке по умолчанию, а стадии custom - после них.
+// Встроенные стадии отключенных функций в order пропускаются; стадии определения транспорта,
+// расписания и маршрута обязательны. Каждая встроенная стадия должна следовать за включенными стадиями,
+// результат которых она использует (stageDependencies).
+func (s *BusTracking) NewPipeline(order []string, custom ...Stage) (*Pipeline, error) {
+	builtin := s.BuiltinStages()
+	if len(order) == 0 {
+		return NewPipeline(append(builtin, custom...)...)
+	}
+	available := make(map[string]Stage, len(builtin)+len(custom))
+	for _, stage := range append(builtin, custom...) {
+		available[stage.Name()] = stage
+	}
+	var stages []Stage
+	for _, name := range order {
+		stage, ok := available[name]
+		if ok {
+			stages = append(stages, stage)
+			continue
+		}
+		switch name {
+		case StageNamespace, StageClock, StageBinding, StageValidate, StageSmooth, StageTrackers, StageMotion, StageMatch, StageGeofence,
+			StageOffRoute, StageHeadway, StageStops, StageAdherence:
+			slog.Warn("pipeline stage is disabled", slog.String("stage", name))
+		default:
+			return nil, fmt.Errorf("unknown pipeline stage %q", name)
+		}
+	}
+	for _, name := range requiredStages {
+		if !slices.Contains(order, name) {
+			return nil, fmt.Errorf("pipeline stage %q is required", name)
+		}
+	}
+	position := make(map[string]int, len(stages))
+	for i, stage := range stages {
+		position[stage.Name()] = i
+	}
+	for i, stage := range stages {
+		for _, dependency := range stageDependencies[stage.Name()] {
+			if _, enabled := available[dependency]; !enabled {
+				continue
+			}
+			if j, ok := position[dependency]; !ok || j > i {
+				return nil, fmt.Errorf("pipeline stage %q must follow %q", stage.Name(), dependency)
+			}
+		}
+	}
+	return NewPipeline(stages...)
+}
+
+func (s *BusTracking) namespaceStage(_ context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+	point.Location = s.uids.Apply(point.Location)
+	return nil, nil
+}
+
+func (s *BusTracking) clockStage(_ context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+	point.Location = s.clock.Correct(point.Location)
+	return nil, nil
+}
+
+func (s *BusTracking) validateStage(ctx context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+	err := s.validator.Validate(point.Location, time.Now())
+	if err == nil {
+		return nil, nil
+	}
+	slog.WarnContext(ctx, "validate gps data",
+		slog.String("uid", point.Location.UID),
+		slog.String("receiver", point.Location.Receiver.String()),
+		xslog.Error(err),
+	)
+	var reject *RejectError
+	if errors.As(err, &reject) {
+		return nil, Drop(string(reject.Reason))
+	}
+	return nil, err
+}
+
+func (s *BusTracking) smoothStage(_ context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+	point.Location = s.smoother.Process(point.Location)
+	return nil, nil
+}
+
+// bindingStage определяет транспорт трекера до проверки и сглаживания данных и при переносе трекера на другой
+// транспорт сбрасывает состояние проверки, сглаживания и вычисления курса: точки разных транспортных средств
+// не должны влиять друг на друга. Трекеры, не найденные в справочнике, отбрасывает стадия transport.
+func (s *BusTracking) bindingStage(ctx context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+	if err := s.resolveTransport(ctx, point); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get transport from UID: %w", err)
+	}
+	uid := point.Location.UID
+	if !s.bindings.moved(uid, point.Transport, time.Now()) {
+		return nil, nil
+	}
+	slog.InfoContext(ctx, "tracker moved to another vehicle",
+		slog.String("uid", uid),
+		slog.String("state_number", point.Transport.StateNumber.String()),
+	)
+	if s.validator != nil {
+		s.validator.Reset(uid)
+	}
+	if s.smoother != nil {
+		s.smoother.Reset(uid)
+	}
+	if s.motion != nil {
+		s.motion.Reset(uid)
+	}
+	return nil, nil
+}
+
+func (s *BusTracking) transportStage(ctx context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+	if err := s.resolveTransport(ctx, point); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			slog.WarnContext(ctx, "not found UID in transport", slog.String("uid", point.Location.UID))
+			s.diag.Record(model.DiagnosticUnknownUID, "", "", point.Location, time.Now())
+			return nil, Drop(DropUnknownUID)
+		}
+		return nil, fmt.Errorf("get transport from UID: %w", err)
+	}
+	s.state.Seen(point.Transport.StateNumber, time.Now())
+	return nil, nil
+}
+
+// resolveTransport заполняет транспорт, который обслуживал трекер в момент получения координат, если он еще
+// не определен предыдущей стадией. Трекер без привязки ищется также по исходному UID без пространства имен.
+func (s *BusTracking) resolveTransport(ctx context.Context, point *model.BusTrackingInfo) error {
+	if point.Transport.StateNumber != "" {
+		return nil
+	}
+	gps := point.Location
+	transport, err := s.transport.Get(gps.UID, gps.Time)
+	if errors.Is(err, repository.ErrNotFound) && s.uids != nil {
+		if uid, ok := s.uids.Legacy(gps); ok {
+			if transport, err = s.transport.Get(uid, gps.Time); err == nil {
+				legacyUIDs.Add(gps.UID, 1)
+				slog.DebugContext(ctx, "transport found by source UID",
+					slog.String("uid", gps.UID),
+					slog.String("source_uid", uid),
+				)
+			}
+		}
+	}
+	if err != nil {
+		return err
+	}
+	point.Transport = transport
+	return nil
+}
+
+func (s *BusTracking) trackersStage(_ context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+	if ok, reason := s.merger.Accept(point.Transport, point.Location, time.Now()); !ok {
+		return nil, Drop(reason)
+	}
+	return nil, nil
+}
+
+func (s *BusTracking) motionStage(_ context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+	point.Location = s.motion.Process(point.Transport, point.Location)
+	return nil, nil
+}
+
+func (s *BusTracking) scheduleStage(ctx context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+	stateNumber := point.Transport.StateNumber
+	schedule, scheduleMatch, err := s.currentSchedule(stateNumber, point.Location.Time)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			slog.WarnContext(ctx, "not found schedule for transport",
+				slog.String("state_number", stateNumber.String()),
+				slog.Time("gps_time", point.Location.Time),
+			)
+			s.diag.Record(model.DiagnosticNoSchedule, stateNumber, "", point.Location, time.Now())
+			s.clearAlerts(ctx, stateNumber, point.Location)
+			return nil, Drop(DropNoSchedule)
+		}
+		return nil, fmt.Errorf("get schedule for transport %s: %w", stateNumber, err)
+	}
+	point.Schedule, point.ScheduleMatch = schedule, scheduleMatch
+	return nil, nil
+}
+
+func (s *BusTracking) routeStage(ctx context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+	number := point.Schedule.Number
+	route, err := s.route.GetRoute(number)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			slog.WarnContext(ctx, "not found route", slog.String("route_number", number.String()))
+			s.diag.Record(model.DiagnosticNoRoute, point.Transport.StateNumber, number, point.Location, time.Now())
+			s.clearAlerts(ctx, point.Transport.StateNumber, point.Location)
+			return nil, Drop(DropNoRoute)
+		}
+		return nil, fmt.Errorf("get route %s: %w", number, err)
+	}
+	point.Route = route
+	return nil, nil
+}
+
+func (s *BusTracking) matchStage(ctx context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+	var err error
+	point.Match, err = s.matcher.Match(point.Route.Number, point.Location)
+	if err != nil {
+		// данные без привязки к геометрии маршрута публикуются
+		slog.ErrorContext(ctx, "match gps data to route shape",
+			slog.String("route_number", point.Route.Number.String()),
+			xslog.Error(err),
+		)
+	}
+	return nil, nil
+}
+
+func (s *BusTracking) geofenceStage(ctx context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+	var suppressed string
+	point.Zones, suppressed = s.geofence.Check(point.Location)
+	if suppressed != "" {
+		s.clearAlerts(ctx, point.Transport.StateNumber, point.Location)
+		return nil, Drop(suppressed)
+	}
+	return nil, nil
+}
+
+func (s *BusTracking) offRouteStage(ctx context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+	for _, alert := range s.offRoute.Process(*point) {
+		s.publishAlert(ctx, alert)
+	}
+	return nil, nil
+}
+
+func (s *BusTracking) headwayStage(ctx context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+	for _, alert := range s.headway.Process(*point) {
+		s.publishAlert(ctx, alert)
+	}
+	return nil, nil
+}
+
+func (s *BusTracking) stopsStage(ctx context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+	for _, event := range s.stops.Process(*point) {
+		slog.DebugContext(ctx, "stop event",
+			slog.String("kind", event.Kind.String()),
+			slog.String("state_number", event.StateNumber.String()),
+			slog.String("stop_id", event.Stop.ID.String()),
+		)
+		s.stopEvent.Update(&event)
+	}
+	return nil, nil
+}
+
+func (s *BusTracking) adherenceStage(_ context.Context, point *model.BusTrackingInfo) ([]*model.BusTrackingInfo, error) {
+	if progress, ok := s.stops.Progress(point.Transport.StateNumber); ok {
+		point.Adherence = s.adherence.Evaluate(*point, progress)
+	}
+	return nil, nil
+}
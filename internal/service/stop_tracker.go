@@ -0,0 +1,253 @@
+package service
+
+import (
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+	"github.com/bars43ru/bus2map/pkg/geo"
+	"github.com/bars43ru/bus2map/pkg/xslog"
+)
+
+// StopRules параметры определения прибытия на остановки и прогноза.
+type StopRules struct {
+	Radius       float64       // расстояние до остановки в метрах, в пределах которого транспорт находится на ней
+	History      int           // количество последних измерений времени движения и стоянки, по которым строится прогноз
+	DefaultSpeed float64       // скорость в км/ч для участков без измерений
+	DefaultDwell time.Duration // время стоянки для остановок без измерений
+}
+
+type segmentKey struct {
+	from model.StopID
+	to   model.StopID
+}
+
+type tripState struct {
+	progress  model.TripProgress
+	index     int // индекс последней остановки в последовательности маршрута, -1 - неизвестна
+	arrival   time.Time
+	departure time.Time
+}
+
+// StopTracker определяет прибытие транспорта на остановки и отправление с них
+// и прогнозирует время прибытия на следующие остановки по недавним временам движения между ними.
+type StopTracker struct {
+	stops    *repository.Stop
+	rules    StopRules
+	mu       sync.Mutex
+	vehicles map[model.StateNumber]*tripState
+	travel   map[segmentKey][]time.Duration
+	dwell    map[model.StopID][]time.Duration
+}
+
+func NewStopTracker(stops *repository.Stop, rules StopRules) *StopTracker {
+	return &StopTracker{
+		stops:    stops,
+		rules:    rules,
+		vehicles: make(map[model.StateNumber]*tripState),
+		travel:   make(map[segmentKey][]time.Duration),
+		dwell:    make(map[model.StopID][]time.Duration),
+	}
+}
+
+// Process обновляет положение транспорта в последовательности остановок маршрута и возвращает
+// события прибытия и отправления. Смена маршрута или рейса по расписанию начинает отслеживание заново.
+func (t *StopTracker) Process(info model.BusTrackingInfo) []model.StopEvent {
+	stateNumber := info.Transport.StateNumber
+	route, err := t.stops.GetRouteStops(info.Route.Number)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			slog.Error("get route stops", slog.String("route_number", info.Route.Number.String()), xslog.Error(err))
+		}
+		t.mu.Lock()
+		delete(t.vehicles, stateNumber)
+		t.mu.Unlock()
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	st, ok := t.vehicles[stateNumber]
+	if !ok || st.progress.RouteNumber != route.Number || !st.progress.Schedule.From.Equal(info.Schedule.From) ||
+		st.index >= len(route.Stops) {
+		st = &tripState{index: -1}
+		t.vehicles[stateNumber] = st
+	}
+	st.progress.StateNumber = stateNumber
+	st.progress.UID = info.Location.UID
+	st.progress.RouteNumber = route.Number
+	st.progress.Schedule = info.Schedule
+	st.progress.UpdatedAt = info.Location.Time
+
+	var events []model.StopEvent
+	event := func(kind model.StopEventKind, stop model.RouteStop) {
+		events = append(events, model.StopEvent{
+			Kind:        kind,
+			StateNumber: stateNumber,
+			UID:         info.Location.UID,
+			RouteNumber: route.Number,
+			Stop:        stop,
+			Time:        info.Location.Time,
+		})
+	}
+
+	point := geo.Point{Latitude: info.Location.Latitude, Longitude: info.Location.Longitude}
+	now := info.Location.Time
+	if st.progress.AtStop {
+		if geo.Distance(point, route.Stops[st.index].Location) <= t.rules.Radius {
+			t.predict(st, route, now)
+			return events
+		}
+		st.progress.AtStop = false
+		st.departure = now
+		record(t.dwell, route.Stops[st.index].ID, now.Sub(st.arrival), t.rules.History)
+		event(model.StopDeparture, route.Stops[st.index])
+	}
+
+	if next := t.arrivedAt(st, route, point); next >= 0 {
+		if st.index >= 0 && next == st.index+1 && !st.departure.IsZero() {
+			key := segmentKey{from: route.Stops[st.index].ID, to: route.Stops[next].ID}
+			record(t.travel, key, now.Sub(st.departure), t.rules.History)
+		}
+		st.index = next
+		st.arrival = now
+		st.progress.AtStop = true
+		st.progress.LastStop = route.Stops[next]
+		event(model.StopArrival, route.Stops[next])
+	}
+	t.predict(st, route, now)
+	return events
+}
+
+// arrivedAt возвращает индекс остановки, на которую прибыл транспорт, или -1. Предпочтение отдается ближайшей
+// по порядку остановке после последней пройденной; остановки позади учитываются, только если последовательность
+// еще неизвестна или пройдена до конца.
+func (t *StopTracker) arrivedAt(st *tripState, route model.RouteStops, point geo.Point) int {
+	restart := st.index < 0 || st.index == len(route.Stops)-1
+	best, bestDistance := -1, 0.0
+	for i, stop := range route.Stops {
+		distance := geo.Distance(point, stop.Location)
+		if distance > t.rules.Radius || i == st.index {
+			continue
+		}
+		if i > st.index {
+			return i
+		}
+		if restart && (best < 0 || distance < bestDistance) {
+			best, bestDistance = i, distance
+		}
+	}
+	return best
+}
+
+// record сохраняет измерение, оставляя не более limit последних.
+func record[K comparable](data map[K][]time.Duration, key K, d time.Duration, limit int) {
+	if d <= 0 {
+		return
+	}
+	items := append(data[key], d)
+	if len(items) > limit {
+		items = items[len(items)-limit:]
+	}
+	data[key] = items
+}
+
+// predict строит прогноз по оставшимся остановкам маршрута.
+func (t *StopTracker) predict(st *tripState, route model.RouteStops, now time.Time) {
+	st.progress.Predictions = st.progress.Predictions[:0]
+	if st.index < 0 {
+		return
+	}
+	current := route.Stops[st.index]
+	clock := st.departure
+	if st.progress.AtStop {
+		clock = maxTime(st.arrival.Add(t.dwellTime(current.ID)), now)
+		st.progress.Predictions = append(st.progress.Predictions, model.StopPrediction{
+			Stop:      current,
+			Arrival:   st.arrival,
+			Departure: clock,
+		})
+	}
+	for i := st.index + 1; i < len(route.Stops); i++ {
+		arrival := clock.Add(t.travelTime(route.Stops[i-1], route.Stops[i]))
+		if i == st.index+1 {
+			arrival = maxTime(arrival, now)
+		}
+		clock = arrival.Add(t.dwellTime(route.Stops[i].ID))
+		st.progress.Predictions = append(st.progress.Predictions, model.StopPrediction{
+			Stop:      route.Stops[i],
+			Arrival:   arrival,
+			Departure: clock,
+		})
+	}
+}
+
+func (t *StopTracker) travelTime(from, to model.RouteStop) time.Duration {
+	if d, ok := average(t.travel[segmentKey{from: from.ID, to: to.ID}]); ok {
+		return d
+	}
+	if t.rules.DefaultSpeed <= 0 {
+		return 0
+	}
+	distance := geo.Distance(from.Location, to.Location)
+	return time.Duration(distance / (t.rules.DefaultSpeed / 3.6) * float64(time.Second)).Round(time.Second)
+}
+
+func (t *StopTracker) dwellTime(stop model.StopID) time.Duration {
+	if d, ok := average(t.dwell[stop]); ok {
+		return d
+	}
+	return t.rules.DefaultDwell
+}
+
+// Progress возвращает положение транспорта в последовательности остановок маршрута.
+func (t *StopTracker) Progress(stateNumber model.StateNumber) (model.TripProgress, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	st, ok := t.vehicles[stateNumber]
+	if !ok || st.index < 0 {
+		return model.TripProgress{}, false
+	}
+	progress := st.progress
+	progress.Predictions = slices.Clone(progress.Predictions)
+	return progress, true
+}
+
+// Trips возвращает положение транспортных средств на маршрутах с прогнозом, упорядоченное по госномеру.
+func (t *StopTracker) Trips() []model.TripProgress {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	items := make([]model.TripProgress, 0, len(t.vehicles))
+	for _, st := range t.vehicles {
+		progress := st.progress
+		progress.Predictions = slices.Clone(progress.Predictions)
+		items = append(items, progress)
+	}
+	slices.SortFunc(items, func(a, b model.TripProgress) int {
+		return strings.Compare(a.StateNumber.String(), b.StateNumber.String())
+	})
+	return items
+}
+
+func average(items []time.Duration) (time.Duration, bool) {
+	if len(items) == 0 {
+		return 0, false
+	}
+	var sum time.Duration
+	for _, d := range items {
+		sum += d
+	}
+	return sum / time.Duration(len(items)), true
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+)
+
+func TestStopTracker(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "stops.txt")
+	// Остановки через ~580 м по долготе
+	data := "1;1;a;A;58.6;49.61\n1;2;b;B;58.6;49.62\n1;3;c;C;58.6;49.63\n"
+	require.NoError(t, os.WriteFile(file, []byte(data), 0o644))
+	stops := repository.NewStop(file)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() { _ = stops.Run(ctx) }()
+	require.Eventually(t, func() bool {
+		_, err := stops.GetRouteStops("1")
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+
+	tracker := NewStopTracker(stops, StopRules{Radius: 30, History: 3, DefaultSpeed: 36, DefaultDwell: 10 * time.Second})
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	point := func(seconds int, lon float64) model.BusTrackingInfo {
+		return model.BusTrackingInfo{
+			Route:     model.Route{Number: "1"},
+			Transport: model.Transport{StateNumber: "A001AA"},
+			Schedule:  model.Schedule{Number: "1", From: start},
+			Location:  model.GPS{UID: "1", Time: start.Add(time.Duration(seconds) * time.Second), Latitude: 58.6, Longitude: lon},
+		}
+	}
+
+	require.Empty(t, tracker.Process(point(0, 49.605)), "position in sequence is unknown")
+	require.Empty(t, tracker.Trips()[0].Predictions)
+
+	events := tracker.Process(point(10, 49.6101))
+	require.Len(t, events, 1)
+	require.Equal(t, model.StopArrival, events[0].Kind)
+	require.Equal(t, model.StopID("a"), events[0].Stop.ID)
+
+	trip := tracker.Trips()[0]
+	require.True(t, trip.AtStop)
+	require.Len(t, trip.Predictions, 3)
+	require.Equal(t, start.Add(20*time.Second), trip.Predictions[0].Departure, "default dwell")
+	// 580 м при 36 км/ч - 58 секунд
+	require.WithinDuration(t, start.Add(78*time.Second), trip.Predictions[1].Arrival, 2*time.Second)
+
+	events = tracker.Process(point(40, 49.612))
+	require.Len(t, events, 1)
+	require.Equal(t, model.StopDeparture, events[0].Kind)
+
+	events = tracker.Process(point(140, 49.62))
+	require.Len(t, events, 1)
+	require.Equal(t, model.StopID("b"), events[0].Stop.ID)
+
+	events = tracker.Process(point(200, 49.63))
+	require.Len(t, events, 2, "departure from b and arrival at c")
+	require.Equal(t, model.StopArrival, events[1].Kind)
+	require.Equal(t, model.StopID("c"), events[1].Stop.ID)
+
+	// Следующий рейс на участке a-b использует измеренное время движения 100 секунд
+	tracker.Process(point(1000, 49.61))
+	tracker.Process(point(1010, 49.612))
+	trip = tracker.Trips()[0]
+	require.Equal(t, model.StopID("b"), trip.Predictions[0].Stop.ID)
+	require.Equal(t, start.Add(1110*time.Second), trip.Predictions[0].Arrival)
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bars43ru/bus2map/internal/model"
+)
+
+// trackerBindingTTL время, после которого забывается привязка трекера, от которого нет данных
+const trackerBindingTTL = 24 * time.Hour
+
+type trackerBinding struct {
+	transport model.Transport
+	seen      time.Time
+}
+
+// trackerBindings запоминает действующую привязку каждого трекера к транспорту.
+type trackerBindings struct {
+	mu   sync.Mutex
+	last map[string]trackerBinding
+}
+
+func newTrackerBindings() *trackerBindings {
+	return &trackerBindings{
+		last: make(map[string]trackerBinding),
+	}
+}
+
+// moved запоминает привязку трекера uid, по которой определен транспорт точки, и возвращает true, если
+// трекер перенесен на другой транспорт. Привязка, начавшаяся раньше запомненной (точка пришла не по порядку),
+// запомненную привязку не меняет.
+func (b *trackerBindings) moved(uid string, transport model.Transport, now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	last, ok := b.last[uid]
+	if !ok {
+		b.expire(now)
+		b.last[uid] = trackerBinding{transport: transport, seen: now}
+		return false
+	}
+	if transport.ValidFrom.Before(last.transport.ValidFrom) {
+		last.seen = now
+		b.last[uid] = last
+		return false
+	}
+	b.last[uid] = trackerBinding{transport: transport, seen: now}
+	return transport.StateNumber != last.transport.StateNumber
+}
+
+// expire удаляет привязки трекеров, от которых нет данных дольше trackerBindingTTL.
+func (b *trackerBindings) expire(now time.Time) {
+	for uid, binding := range b.last {
+		if now.Sub(binding.seen) > trackerBindingTTL {
+			delete(b.last, uid)
+		}
+	}
+}
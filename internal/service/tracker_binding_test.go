@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/internal/repository"
+)
+
+func TestBusTracking_ResetMovedTracker(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "transport.txt")
+	data := "dev1;A001AA;bus;;;01/01/2025T12:00:00Z+00:00\n" +
+		"dev1;B002BB;bus;;01/01/2025T12:00:00Z+00:00;\n"
+	require.NoError(t, os.WriteFile(file, []byte(data), 0o644))
+	transport := repository.NewTransport(file)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() { _ = transport.Run(ctx) }()
+	require.Eventually(t, func() bool {
+		_, err := transport.Get("dev1", time.Now())
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+
+	s := New(Options{
+		Transport: transport,
+		Validator: NewValidator(ValidationRules{MaxSpeed: 150}),
+	})
+	start := time.Date(2025, 1, 1, 11, 59, 0, 0, time.UTC)
+	process := func(seconds int, lat float64) error {
+		point := &model.BusTrackingInfo{Location: model.GPS{
+			UID:       "dev1",
+			Time:      start.Add(time.Duration(seconds) * time.Second),
+			Latitude:  lat,
+			Longitude: 49.6,
+		}}
+		if _, err := s.bindingStage(ctx, point); err != nil {
+			return err
+		}
+		_, err := s.validateStage(ctx, point)
+		return err
+	}
+
+	require.NoError(t, process(0, 58.6))
+	var drop *DropError
+	require.ErrorAs(t, process(30, 58.7), &drop, "jump on the same vehicle is rejected")
+	require.Equal(t, string(RejectSpeed), drop.Reason)
+	require.NoError(t, process(60, 58.7), "jump after the tracker moved to another vehicle is accepted")
+	require.ErrorAs(t, process(30, 58.6), &drop, "out of order point of the previous binding doesn't reset state")
+	require.Equal(t, string(RejectOutOfOrder), drop.Reason)
+	require.NoError(t, process(90, 58.7001))
+}
+
+func TestTrackerBindings(t *testing.T) {
+	b := newTrackerBindings()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	first := model.Transport{StateNumber: "A001AA"}
+	second := model.Transport{StateNumber: "B002BB", ValidFrom: now}
+
+	require.False(t, b.moved("dev1", first, now))
+	require.False(t, b.moved("dev1", first, now))
+	require.True(t, b.moved("dev1", second, now))
+	require.False(t, b.moved("dev1", first, now), "earlier binding doesn't replace the current one")
+	require.False(t, b.moved("dev1", second, now))
+
+	require.False(t, b.moved("dev2", first, now.Add(trackerBindingTTL+time.Minute)))
+	require.False(t, b.moved("dev1", first, now.Add(trackerBindingTTL+time.Minute)), "silent tracker is forgotten")
+}
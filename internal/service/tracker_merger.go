@@ -0,0 +1,72 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bars43ru/bus2map/internal/model"
+)
+
+// Причины отбрасывания данных объединением трекеров
+const (
+	DropStandbyTracker = "standby_tracker" // данные резервного трекера при работающем основном
+	DropStaleTracker   = "stale_tracker"   // данные старее уже опубликованных от другого трекера
+)
+
+type trackerSource struct {
+	uid      string
+	priority int
+	seenAt   time.Time // время получения последних данных от трекера
+	last     time.Time // время последних принятых данных
+}
+
+// TrackerMerger объединяет данные нескольких трекеров одного транспорта. Публикуются данные только
+// активного трекера: трекер с более высоким приоритетом становится активным сразу, трекер с тем же
+// или более низким приоритетом - после молчания активного трекера в течение silence.
+type TrackerMerger struct {
+	silence time.Duration
+	mu      sync.Mutex
+	active  map[model.StateNumber]*trackerSource
+}
+
+func NewTrackerMerger(silence time.Duration) *TrackerMerger {
+	return &TrackerMerger{
+		silence: silence,
+		active:  make(map[model.StateNumber]*trackerSource),
+	}
+}
+
+// Accept решает, публиковать ли данные gps трекера transport, и возвращает причину отказа.
+// Время получения данных берется из gps.ReceivedAt, а если оно не задано, используется now.
+func (m *TrackerMerger) Accept(transport model.Transport, gps model.GPS, now time.Time) (bool, string) {
+	seenAt := gps.ReceivedAt
+	if seenAt.IsZero() {
+		seenAt = now
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	source, ok := m.active[transport.StateNumber]
+	switch {
+	case !ok:
+		source = &trackerSource{}
+		m.active[transport.StateNumber] = source
+	case source.uid == gps.UID:
+		source.seenAt = seenAt
+		if gps.Time.After(source.last) {
+			source.last = gps.Time
+		}
+		return true, ""
+	case transport.Priority >= source.priority && seenAt.Sub(source.seenAt) <= m.silence:
+		return false, DropStandbyTracker
+	case !gps.Time.After(source.last):
+		// переключение на трекер, данные которого отстают от опубликованных, смешало бы треки
+		return false, DropStaleTracker
+	}
+	*source = trackerSource{
+		uid:      gps.UID,
+		priority: transport.Priority,
+		seenAt:   seenAt,
+		last:     gps.Time,
+	}
+	return true, ""
+}
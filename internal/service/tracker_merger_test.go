@@ -0,0 +1,45 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+)
+
+func TestTrackerMerger(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	m := NewTrackerMerger(time.Minute)
+	primary := model.Transport{GUID: "egts", StateNumber: "A001AA", Priority: 0}
+	secondary := model.Transport{GUID: "wialon", StateNumber: "A001AA", Priority: 1}
+	accept := func(transport model.Transport, seconds int) string {
+		at := start.Add(time.Duration(seconds) * time.Second)
+		ok, reason := m.Accept(transport, model.GPS{UID: transport.GUID, Time: at, ReceivedAt: at}, at)
+		if ok {
+			return "accepted"
+		}
+		return reason
+	}
+
+	require.Equal(t, "accepted", accept(secondary, 0), "the first tracker becomes active")
+	require.Equal(t, "accepted", accept(primary, 10), "primary tracker takes over immediately")
+	require.Equal(t, DropStandbyTracker, accept(secondary, 20))
+	require.Equal(t, "accepted", accept(primary, 30))
+	require.Equal(t, DropStandbyTracker, accept(secondary, 90), "primary is silent less than timeout")
+	require.Equal(t, "accepted", accept(secondary, 91), "failover after primary silence")
+	require.Equal(t, "accepted", accept(secondary, 100))
+	require.Equal(t, "accepted", accept(primary, 110), "primary tracker is preferred when it reports again")
+
+	ok, reason := m.Accept(secondary, model.GPS{
+		UID:        secondary.GUID,
+		Time:       start.Add(100 * time.Second),
+		ReceivedAt: start.Add(300 * time.Second),
+	}, start)
+	require.False(t, ok)
+	require.Equal(t, DropStaleTracker, reason, "tracks are not interleaved on failover")
+
+	other := model.Transport{GUID: "other", StateNumber: "B002BB", Priority: 1}
+	require.Equal(t, "accepted", accept(other, 120), "vehicles are merged independently")
+}
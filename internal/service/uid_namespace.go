@@ -0,0 +1,75 @@
+package service
+
+import (
+	"expvar"
+	"regexp"
+	"strings"
+
+	"github.com/bars43ru/bus2map/internal/model"
+)
+
+// legacyUIDs количество GPS-данных, сопоставленных с транспортом по исходному UID, в разрезе UID
+// с пространством имен, доступно по /debug/vars. Показывает записи справочника транспорта, которые нужно перевести
+// на UID с пространством имен.
+var legacyUIDs = expvar.NewMap("uid_legacy_matches")
+
+// UIDTransform преобразование UID трекера: замена по регулярному выражению, удаление ведущих нулей
+// и добавление префикса выполняются в этом порядке.
+type UIDTransform struct {
+	Prefix     string
+	Pattern    *regexp.Regexp // если задано, UID заменяется по шаблону Replace
+	Replace    string         // шаблон замены в формате regexp.Regexp.ReplaceAllString
+	StripZeros bool
+}
+
+// Apply возвращает преобразованный UID.
+func (t UIDTransform) Apply(uid string) string {
+	if t.Pattern != nil {
+		uid = t.Pattern.ReplaceAllString(uid, t.Replace)
+	}
+	if t.StripZeros {
+		if stripped := strings.TrimLeft(uid, "0"); stripped != "" {
+			uid = stripped
+		} else if uid != "" {
+			uid = "0"
+		}
+	}
+	return t.Prefix + uid
+}
+
+// UIDNamespaces пространства имен UID трекеров по приемникам, исключающие совпадение UID
+// трекеров, подключенных по разным протоколам.
+type UIDNamespaces struct {
+	receivers map[model.Receiver]UIDTransform
+	legacy    bool
+}
+
+// NewUIDNamespaces создает пространства имен UID для приемников receivers; UID от остальных приемников
+// не преобразуются. Если legacy, трекер, не найденный в справочнике транспорта по UID с пространством
+// имен, ищется по исходному UID, что позволяет переводить справочник постепенно.
+func NewUIDNamespaces(receivers map[model.Receiver]UIDTransform, legacy bool) *UIDNamespaces {
+	return &UIDNamespaces{
+		receivers: receivers,
+		legacy:    legacy,
+	}
+}
+
+// Apply возвращает данные с UID в пространстве имен приемника; исходный UID сохраняется в SourceUID.
+func (n *UIDNamespaces) Apply(gps model.GPS) model.GPS {
+	transform, ok := n.receivers[gps.Receiver]
+	if !ok {
+		return gps
+	}
+	gps.SourceUID = gps.UID
+	gps.UID = transform.Apply(gps.UID)
+	return gps
+}
+
+// Legacy возвращает исходный UID, по которому нужно искать трекер в справочнике транспорта,
+// если он не найден по UID с пространством имен.
+func (n *UIDNamespaces) Legacy(gps model.GPS) (string, bool) {
+	if !n.legacy || gps.SourceUID == "" || gps.SourceUID == gps.UID {
+		return "", false
+	}
+	return gps.SourceUID, true
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+)
+
+func TestUIDNamespaces(t *testing.T) {
+	n := NewUIDNamespaces(map[model.Receiver]UIDTransform{
+		model.ReceiverEGTS: {Prefix: "egts.", StripZeros: true},
+		model.ReceiverWialonIPS: {
+			Prefix:  "wialon.",
+			Pattern: regexp.MustCompile(`^IMEI-(\d+)$`),
+			Replace: "$1",
+		},
+	}, true)
+
+	tests := []struct {
+		receiver model.Receiver
+		uid      string
+		want     string
+	}{
+		{receiver: model.ReceiverEGTS, uid: "0012345", want: "egts.12345"},
+		{receiver: model.ReceiverEGTS, uid: "000", want: "egts.0"},
+		{receiver: model.ReceiverWialonIPS, uid: "IMEI-0012345", want: "wialon.0012345"},
+		{receiver: model.ReceiverWialonIPS, uid: "12345", want: "wialon.12345"},
+		{receiver: model.ReceiverGRPC, uid: "12345", want: "12345"},
+	}
+	for _, tt := range tests {
+		gps := n.Apply(model.GPS{UID: tt.uid, Receiver: tt.receiver})
+		require.Equal(t, tt.want, gps.UID, "%s %s", tt.receiver, tt.uid)
+
+		uid, ok := n.Legacy(gps)
+		if tt.want == tt.uid {
+			require.False(t, ok, "UID without namespace has no legacy lookup")
+			continue
+		}
+		require.True(t, ok)
+		require.Equal(t, tt.uid, uid)
+	}
+
+	n = NewUIDNamespaces(map[model.Receiver]UIDTransform{model.ReceiverEGTS: {Prefix: "egts."}}, false)
+	_, ok := n.Legacy(n.Apply(model.GPS{UID: "1", Receiver: model.ReceiverEGTS}))
+	require.False(t, ok, "legacy lookup is disabled")
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"expvar"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/pkg/geo"
+)
+
+// RejectReason причина, по которой GPS-данные не допущены к обработке
+type RejectReason string
+
+const (
+	RejectFutureTime  RejectReason = "future_time"  // время точки опережает время сервера
+	RejectStaleTime   RejectReason = "stale_time"   // время точки слишком отстает от времени сервера
+	RejectCoordinates RejectReason = "coordinates"  // недопустимые координаты или 0/0
+	RejectOutOfArea   RejectReason = "out_of_area"  // координаты вне области обслуживания
+	RejectDuplicate   RejectReason = "duplicate"    // повтор точки с тем же временем
+	RejectOutOfOrder  RejectReason = "out_of_order" // точка старее последней принятой
+	RejectSpeed       RejectReason = "speed"        // неправдоподобная скорость перемещения от предыдущей точки
+)
+
+// rejectedGPS количество отклоненных точек в разрезе причин, доступно по /debug/vars
+var rejectedGPS = expvar.NewMap("gps_rejected")
+
+// RejectError ошибка, возвращаемая при отклонении GPS-данных.
+type RejectError struct {
+	Reason RejectReason
+	Detail string
+}
+
+func (e *RejectError) Error() string {
+	return fmt.Sprintf("gps data rejected (%s): %s", e.Reason, e.Detail)
+}
+
+// ValidationRules правила проверки GPS-данных. Нулевое значение правила отключает его.
+type ValidationRules struct {
+	MaxFutureSkew time.Duration // допустимое опережение времени точки относительно времени сервера
+	MaxPastSkew   time.Duration // допустимое отставание времени точки от времени сервера
+	MaxSpeed      float64       // максимальная правдоподобная скорость между соседними точками, км/ч
+	SpeedWindow   time.Duration // проверка скорости выполняется, если предыдущая точка не старше окна
+	Area          *geo.BBox     // область обслуживания
+}
+
+// reanchorPoints количество отклоненных подряд по скорости точек, согласованных между собой, после которого
+// последняя из них принимается: неверной была предыдущая принятая точка, а не новые
+const reanchorPoints = 3
+
+// Validator отсеивает недостоверные GPS-данные до их сопоставления со справочниками.
+type Validator struct {
+	rules    ValidationRules
+	mu       sync.Mutex
+	last     map[string]model.GPS
+	rejected map[string][]model.GPS // отклоненные подряд по скорости точки, согласованные между собой
+}
+
+func NewValidator(rules ValidationRules) *Validator {
+	return &Validator{
+		rules:    rules,
+		last:     make(map[string]model.GPS),
+		rejected: make(map[string][]model.GPS),
+	}
+}
+
+// Validate проверяет точку и, если она принята, запоминает ее как последнюю для UID.
+func (v *Validator) Validate(gps model.GPS, now time.Time) error {
+	err := v.validate(gps, now)
+	if err != nil {
+		rejectedGPS.Add(string(err.Reason), 1)
+		return err
+	}
+	return nil
+}
+
+// Reset забывает последнюю принятую точку UID, например после переноса трекера на другой транспорт.
+func (v *Validator) Reset(uid string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	delete(v.last, uid)
+	delete(v.rejected, uid)
+}
+
+func (v *Validator) validate(gps model.GPS, now time.Time) *RejectError {
+	if v.rules.MaxFutureSkew > 0 && gps.Time.Sub(now) > v.rules.MaxFutureSkew {
+		return &RejectError{Reason: RejectFutureTime, Detail: fmt.Sprintf("time %s ahead of server", gps.Time.Sub(now))}
+	}
+	if v.rules.MaxPastSkew > 0 && now.Sub(gps.Time) > v.rules.MaxPastSkew {
+		return &RejectError{Reason: RejectStaleTime, Detail: fmt.Sprintf("time %s behind server", now.Sub(gps.Time))}
+	}
+	point := geo.Point{Latitude: gps.Latitude, Longitude: gps.Longitude}
+	if !point.Valid() {
+		return &RejectError{Reason: RejectCoordinates, Detail: fmt.Sprintf("%f,%f", gps.Latitude, gps.Longitude)}
+	}
+	if v.rules.Area != nil && !v.rules.Area.Contains(point) {
+		return &RejectError{Reason: RejectOutOfArea, Detail: fmt.Sprintf("%f,%f", gps.Latitude, gps.Longitude)}
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	prev, ok := v.last[gps.UID]
+	if ok {
+		switch {
+		case gps.Time.Equal(prev.Time):
+			return &RejectError{Reason: RejectDuplicate, Detail: gps.Time.String()}
+		case gps.Time.Before(prev.Time):
+			return &RejectError{Reason: RejectOutOfOrder, Detail: fmt.Sprintf("%s before %s", gps.Time, prev.Time)}
+		}
+		if speed, distance, ok := v.speed(prev, gps); ok && speed > v.rules.MaxSpeed && !v.reanchor(gps) {
+			return &RejectError{Reason: RejectSpeed, Detail: fmt.Sprintf("%.0f km/h over %.0f m", speed, distance)}
+		}
+	}
+	v.last[gps.UID] = gps
+	delete(v.rejected, gps.UID)
+	return nil
+}
+
+// speed возвращает скорость (км/ч) и расстояние (м) между точками, если скорость требуется проверять.
+func (v *Validator) speed(prev, gps model.GPS) (float64, float64, bool) {
+	dt := gps.Time.Sub(prev.Time)
+	if v.rules.MaxSpeed <= 0 || dt <= 0 || (v.rules.SpeedWindow > 0 && dt > v.rules.SpeedWindow) {
+		return 0, 0, false
+	}
+	distance := geo.Distance(
+		geo.Point{Latitude: prev.Latitude, Longitude: prev.Longitude},
+		geo.Point{Latitude: gps.Latitude, Longitude: gps.Longitude},
+	)
+	return distance / dt.Seconds() * 3.6, distance, true
+}
+
+// reanchor запоминает точку, отклоненную по скорости, и возвращает true, если она вместе с предыдущими
+// отклоненными точками образует reanchorPoints согласованных между собой точек.
+func (v *Validator) reanchor(gps model.GPS) bool {
+	rejected := v.rejected[gps.UID]
+	if n := len(rejected); n > 0 {
+		if speed, _, ok := v.speed(rejected[n-1], gps); !ok || speed > v.rules.MaxSpeed {
+			rejected = rejected[:0]
+		}
+	}
+	rejected = append(rejected, gps)
+	if len(rejected) < reanchorPoints {
+		v.rejected[gps.UID] = rejected
+		return false
+	}
+	return true
+}
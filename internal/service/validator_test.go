@@ -0,0 +1,70 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bars43ru/bus2map/internal/model"
+	"github.com/bars43ru/bus2map/pkg/geo"
+)
+
+func requireRejected(t *testing.T, err error, reason RejectReason) {
+	t.Helper()
+	var rejectErr *RejectError
+	require.ErrorAs(t, err, &rejectErr)
+	require.Equal(t, reason, rejectErr.Reason)
+}
+
+func TestValidator(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	point := func(uid string, at time.Time, lat, lon float64) model.GPS {
+		return model.GPS{UID: uid, Time: at, Latitude: lat, Longitude: lon}
+	}
+	v := NewValidator(ValidationRules{
+		MaxFutureSkew: time.Minute,
+		MaxPastSkew:   time.Hour,
+		MaxSpeed:      120,
+		SpeedWindow:   10 * time.Minute,
+		Area:          &geo.BBox{Min: geo.Point{Latitude: 58, Longitude: 49}, Max: geo.Point{Latitude: 59, Longitude: 50}},
+	})
+
+	t.Run("clock skew", func(t *testing.T) {
+		requireRejected(t, v.Validate(point("1", now.Add(2*time.Minute), 58.6, 49.6), now), RejectFutureTime)
+		requireRejected(t, v.Validate(point("1", now.Add(-2*time.Hour), 58.6, 49.6), now), RejectStaleTime)
+	})
+	t.Run("coordinates", func(t *testing.T) {
+		requireRejected(t, v.Validate(point("1", now, 0, 0), now), RejectCoordinates)
+		requireRejected(t, v.Validate(point("1", now, 55.75, 37.61), now), RejectOutOfArea)
+	})
+	t.Run("order", func(t *testing.T) {
+		require.NoError(t, v.Validate(point("2", now.Add(-time.Minute), 58.6, 49.6), now))
+		requireRejected(t, v.Validate(point("2", now.Add(-time.Minute), 58.6, 49.6), now), RejectDuplicate)
+		requireRejected(t, v.Validate(point("2", now.Add(-2*time.Minute), 58.6, 49.6), now), RejectOutOfOrder)
+	})
+	t.Run("speed", func(t *testing.T) {
+		require.NoError(t, v.Validate(point("3", now.Add(-5*time.Minute), 58.60, 49.60), now))
+		// ~1.1 км за минуту - 67 км/ч
+		require.NoError(t, v.Validate(point("3", now.Add(-4*time.Minute), 58.61, 49.60), now))
+		// ~22 км за минуту
+		requireRejected(t, v.Validate(point("3", now.Add(-3*time.Minute), 58.81, 49.60), now), RejectSpeed)
+		require.NoError(t, v.Validate(point("3", now.Add(-2*time.Minute), 58.62, 49.60), now))
+	})
+	t.Run("teleported anchor", func(t *testing.T) {
+		// первая точка принята с неверными координатами, последующие точки верны и согласованы между собой
+		require.NoError(t, v.Validate(point("4", now.Add(-10*time.Minute), 58.90, 49.60), now))
+		requireRejected(t, v.Validate(point("4", now.Add(-9*time.Minute), 58.600, 49.60), now), RejectSpeed)
+		requireRejected(t, v.Validate(point("4", now.Add(-8*time.Minute), 58.601, 49.60), now), RejectSpeed)
+		require.NoError(t, v.Validate(point("4", now.Add(-7*time.Minute), 58.602, 49.60), now),
+			"consecutive consistent points replace the anchor")
+		require.NoError(t, v.Validate(point("4", now.Add(-6*time.Minute), 58.603, 49.60), now))
+	})
+	t.Run("inconsistent outliers", func(t *testing.T) {
+		require.NoError(t, v.Validate(point("5", now.Add(-10*time.Minute), 58.60, 49.60), now))
+		requireRejected(t, v.Validate(point("5", now.Add(-9*time.Minute), 58.90, 49.60), now), RejectSpeed)
+		requireRejected(t, v.Validate(point("5", now.Add(-8*time.Minute), 58.30, 49.60), now), RejectSpeed)
+		requireRejected(t, v.Validate(point("5", now.Add(-7*time.Minute), 58.95, 49.60), now), RejectSpeed)
+		require.NoError(t, v.Validate(point("5", now.Add(-6*time.Minute), 58.601, 49.60), now))
+	})
+}
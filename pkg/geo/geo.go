@@ -0,0 +1,61 @@
+package geo
+
+import (
+	"math"
+)
+
+// EarthRadius средний радиус Земли в метрах
+const EarthRadius = 6371008.8
+
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Distance возвращает расстояние по дуге большого круга между точками в метрах.
+func Distance(a, b Point) float64 {
+	lat1, lat2 := radians(a.Latitude), radians(b.Latitude)
+	dLat := lat2 - lat1
+	dLon := radians(b.Longitude - a.Longitude)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Bearing возвращает начальный азимут движения из a в b в градусах (север - 0, по часовой стрелке) в диапазоне [0, 360).
+func Bearing(a, b Point) float64 {
+	lat1, lat2 := radians(a.Latitude), radians(b.Latitude)
+	dLon := radians(b.Longitude - a.Longitude)
+	y := math.Sin(dLon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLon)
+	return math.Mod(degrees(math.Atan2(y, x))+360, 360)
+}
+
+// Valid проверяет, что координаты находятся в допустимом диапазоне и не равны 0/0.
+func (p Point) Valid() bool {
+	if math.IsNaN(p.Latitude) || math.IsNaN(p.Longitude) {
+		return false
+	}
+	if p.Latitude < -90 || p.Latitude > 90 || p.Longitude < -180 || p.Longitude > 180 {
+		return false
+	}
+	return p.Latitude != 0 || p.Longitude != 0
+}
+
+// BBox прямоугольная область, заданная минимальными и максимальными координатами.
+type BBox struct {
+	Min Point
+	Max Point
+}
+
+func (b BBox) Contains(p Point) bool {
+	return p.Latitude >= b.Min.Latitude && p.Latitude <= b.Max.Latitude &&
+		p.Longitude >= b.Min.Longitude && p.Longitude <= b.Max.Longitude
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
@@ -0,0 +1,51 @@
+package geo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistance(t *testing.T) {
+	// Киров, Театральная площадь - ж/д вокзал
+	a := Point{Latitude: 58.6035, Longitude: 49.6668}
+	b := Point{Latitude: 58.5836, Longitude: 49.6255}
+	require.InDelta(t, 3200, Distance(a, b), 100)
+	require.Zero(t, Distance(a, a))
+	// Один градус по меридиану
+	require.InDelta(t, 111195, Distance(Point{0, 0}, Point{1, 0}), 1)
+}
+
+func TestBearing(t *testing.T) {
+	tests := []struct {
+		name string
+		to   Point
+		want float64
+	}{
+		{name: "north", to: Point{Latitude: 1, Longitude: 0}, want: 0},
+		{name: "east", to: Point{Latitude: 0, Longitude: 1}, want: 90},
+		{name: "south", to: Point{Latitude: -1, Longitude: 0}, want: 180},
+		{name: "west", to: Point{Latitude: 0, Longitude: -1}, want: 270},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.InDelta(t, tt.want, Bearing(Point{0, 0}, tt.to), 1e-9)
+		})
+	}
+}
+
+func TestPointValid(t *testing.T) {
+	require.True(t, Point{Latitude: 58.6, Longitude: 49.6}.Valid())
+	require.False(t, Point{}.Valid())
+	require.False(t, Point{Latitude: 91, Longitude: 10}.Valid())
+	require.False(t, Point{Latitude: 10, Longitude: -181}.Valid())
+	require.False(t, Point{Latitude: math.NaN(), Longitude: 10}.Valid())
+}
+
+func TestBBoxContains(t *testing.T) {
+	b := BBox{Min: Point{Latitude: 58, Longitude: 49}, Max: Point{Latitude: 59, Longitude: 50}}
+	require.True(t, b.Contains(Point{Latitude: 58.5, Longitude: 49.5}))
+	require.True(t, b.Contains(Point{Latitude: 58, Longitude: 50}))
+	require.False(t, b.Contains(Point{Latitude: 57.9, Longitude: 49.5}))
+}
@@ -0,0 +1,124 @@
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// FeatureCollection минимальное подмножество формата GeoJSON (RFC 7946), необходимое для справочников.
+type FeatureCollection struct {
+	Type     string    `json:"type"`
+	Features []Feature `json:"features"`
+}
+
+type Feature struct {
+	Type       string         `json:"type"`
+	ID         any            `json:"id"`
+	Properties map[string]any `json:"properties"`
+	Geometry   Geometry       `json:"geometry"`
+}
+
+type Geometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// Property возвращает строковое значение свойства объекта, для чисел - их текстовое представление.
+func (f Feature) Property(name string) string {
+	v, ok := f.Properties[name]
+	if !ok || v == nil {
+		return ""
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return fmt.Sprint(v)
+}
+
+// Point возвращает координаты геометрии типа Point.
+func (g Geometry) Point() (Point, error) {
+	if g.Type != "Point" {
+		return Point{}, fmt.Errorf("unexpected geometry type `%s`, want Point", g.Type)
+	}
+	var c []float64
+	if err := json.Unmarshal(g.Coordinates, &c); err != nil {
+		return Point{}, fmt.Errorf("unmarshal coordinates: %w", err)
+	}
+	return toPoint(c)
+}
+
+// LineString возвращает координаты геометрии типа LineString или MultiLineString,
+// части MultiLineString объединяются в одну линию.
+func (g Geometry) LineString() ([]Point, error) {
+	var c [][]float64
+	switch g.Type {
+	case "LineString":
+		if err := json.Unmarshal(g.Coordinates, &c); err != nil {
+			return nil, fmt.Errorf("unmarshal coordinates: %w", err)
+		}
+	case "MultiLineString":
+		var parts [][][]float64
+		if err := json.Unmarshal(g.Coordinates, &parts); err != nil {
+			return nil, fmt.Errorf("unmarshal coordinates: %w", err)
+		}
+		for _, part := range parts {
+			c = append(c, part...)
+		}
+	default:
+		return nil, fmt.Errorf("unexpected geometry type `%s`, want LineString or MultiLineString", g.Type)
+	}
+	return toPoints(c)
+}
+
+// MultiPolygon возвращает координаты геометрии типа Polygon или MultiPolygon.
+func (g Geometry) MultiPolygon() (MultiPolygon, error) {
+	var raw [][][][]float64
+	switch g.Type {
+	case "Polygon":
+		var polygon [][][]float64
+		if err := json.Unmarshal(g.Coordinates, &polygon); err != nil {
+			return nil, fmt.Errorf("unmarshal coordinates: %w", err)
+		}
+		raw = append(raw, polygon)
+	case "MultiPolygon":
+		if err := json.Unmarshal(g.Coordinates, &raw); err != nil {
+			return nil, fmt.Errorf("unmarshal coordinates: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unexpected geometry type `%s`, want Polygon or MultiPolygon", g.Type)
+	}
+
+	result := make(MultiPolygon, 0, len(raw))
+	for _, rawPolygon := range raw {
+		polygon := make(Polygon, 0, len(rawPolygon))
+		for _, rawRing := range rawPolygon {
+			ring, err := toPoints(rawRing)
+			if err != nil {
+				return nil, err
+			}
+			polygon = append(polygon, ring)
+		}
+		result = append(result, polygon)
+	}
+	return result, nil
+}
+
+func toPoints(c [][]float64) ([]Point, error) {
+	points := make([]Point, 0, len(c))
+	for _, v := range c {
+		p, err := toPoint(v)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, p)
+	}
+	return points, nil
+}
+
+// toPoint в GeoJSON координаты задаются в порядке долгота, широта.
+func toPoint(c []float64) (Point, error) {
+	if len(c) < 2 {
+		return Point{}, fmt.Errorf("position must contain longitude and latitude, got %v", c)
+	}
+	return Point{Longitude: c[0], Latitude: c[1]}, nil
+}
@@ -0,0 +1,42 @@
+package geo
+
+// Polygon многоугольник: первое кольцо - внешняя граница, последующие - вырезы.
+type Polygon [][]Point
+
+// MultiPolygon набор многоугольников.
+type MultiPolygon []Polygon
+
+// Contains проверяет, что точка находится внутри внешней границы и вне вырезов.
+func (p Polygon) Contains(point Point) bool {
+	if len(p) == 0 || !ringContains(p[0], point) {
+		return false
+	}
+	for _, hole := range p[1:] {
+		if ringContains(hole, point) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m MultiPolygon) Contains(point Point) bool {
+	for _, p := range m {
+		if p.Contains(point) {
+			return true
+		}
+	}
+	return false
+}
+
+// ringContains проверка принадлежности точки кольцу методом трассировки луча.
+func ringContains(ring []Point, point Point) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a.Latitude > point.Latitude) != (b.Latitude > point.Latitude) &&
+			point.Longitude < (b.Longitude-a.Longitude)*(point.Latitude-a.Latitude)/(b.Latitude-a.Latitude)+a.Longitude {
+			inside = !inside
+		}
+	}
+	return inside
+}
@@ -0,0 +1,35 @@
+package geo
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolygonContains(t *testing.T) {
+	const raw = `{
+		"type": "Polygon",
+		"coordinates": [
+			[[49.0, 58.0], [50.0, 58.0], [50.0, 59.0], [49.0, 59.0], [49.0, 58.0]],
+			[[49.4, 58.4], [49.6, 58.4], [49.6, 58.6], [49.4, 58.6], [49.4, 58.4]]
+		]
+	}`
+	var g Geometry
+	require.NoError(t, json.Unmarshal([]byte(raw), &g))
+	polygon, err := g.MultiPolygon()
+	require.NoError(t, err)
+
+	require.True(t, polygon.Contains(Point{Latitude: 58.2, Longitude: 49.2}))
+	require.False(t, polygon.Contains(Point{Latitude: 58.5, Longitude: 49.5}), "inside hole")
+	require.False(t, polygon.Contains(Point{Latitude: 57.5, Longitude: 49.5}), "outside")
+}
+
+func TestGeometryUnexpectedType(t *testing.T) {
+	_, err := Geometry{Type: "Point", Coordinates: json.RawMessage(`[1,2]`)}.MultiPolygon()
+	require.Error(t, err)
+
+	p, err := Geometry{Type: "Point", Coordinates: json.RawMessage(`[49.6,58.6]`)}.Point()
+	require.NoError(t, err)
+	require.Equal(t, Point{Latitude: 58.6, Longitude: 49.6}, p)
+}
@@ -0,0 +1,123 @@
+package geo
+
+import (
+	"math"
+)
+
+// Polyline ломаная линия с предварительно вычисленными расстояниями от начала до каждой вершины.
+type Polyline struct {
+	Points     []Point
+	cumulative []float64
+}
+
+func NewPolyline(points []Point) Polyline {
+	cumulative := make([]float64, len(points))
+	for i := 1; i < len(points); i++ {
+		cumulative[i] = cumulative[i-1] + Distance(points[i-1], points[i])
+	}
+	return Polyline{
+		Points:     points,
+		cumulative: cumulative,
+	}
+}
+
+// Length длина линии в метрах.
+func (l Polyline) Length() float64 {
+	if len(l.cumulative) == 0 {
+		return 0
+	}
+	return l.cumulative[len(l.cumulative)-1]
+}
+
+// Projection результат проецирования точки на линию.
+type Projection struct {
+	Point         Point   // ближайшая точка на линии
+	DistanceAlong float64 // расстояние от начала линии до Point в метрах
+	Offset        float64 // расстояние от исходной точки до Point в метрах
+}
+
+// Project проецирует точку на ближайший отрезок линии.
+func (l Polyline) Project(p Point) Projection {
+	return l.ProjectNear(p, -1, 0)
+}
+
+// ProjectNear проецирует точку на линию с учетом предыдущего положения along: среди отрезков,
+// удаленных от точки не более чем на tolerance метров дальше ближайшего, выбирается проекция,
+// ближайшая к along. Это исключает перескоки между встречными участками маршрута.
+// Отрицательное значение along отключает учет предыдущего положения.
+func (l Polyline) ProjectNear(p Point, along float64, tolerance float64) Projection {
+	if len(l.Points) == 0 {
+		return Projection{Offset: math.Inf(1)}
+	}
+	if len(l.Points) == 1 {
+		return Projection{Point: l.Points[0], Offset: Distance(p, l.Points[0])}
+	}
+
+	candidates := make([]Projection, 0, len(l.Points)-1)
+	best := math.Inf(1)
+	for i := 1; i < len(l.Points); i++ {
+		c := l.projectSegment(p, i-1)
+		candidates = append(candidates, c)
+		best = math.Min(best, c.Offset)
+	}
+
+	result := Projection{Offset: math.Inf(1)}
+	for _, c := range candidates {
+		if along < 0 {
+			if c.Offset < result.Offset {
+				result = c
+			}
+			continue
+		}
+		if c.Offset > best+tolerance {
+			continue
+		}
+		if math.IsInf(result.Offset, 1) || math.Abs(c.DistanceAlong-along) < math.Abs(result.DistanceAlong-along) {
+			result = c
+		}
+	}
+	return result
+}
+
+// PointAt возвращает точку на линии на расстоянии distance метров от начала.
+func (l Polyline) PointAt(distance float64) Point {
+	if len(l.Points) == 0 {
+		return Point{}
+	}
+	for i := 1; i < len(l.Points); i++ {
+		if distance <= l.cumulative[i] {
+			segment := l.cumulative[i] - l.cumulative[i-1]
+			if segment == 0 {
+				return l.Points[i]
+			}
+			return interpolate(l.Points[i-1], l.Points[i], (distance-l.cumulative[i-1])/segment)
+		}
+	}
+	return l.Points[len(l.Points)-1]
+}
+
+// projectSegment проецирует точку на отрезок i, i+1 в локальной равнопромежуточной проекции.
+func (l Polyline) projectSegment(p Point, i int) Projection {
+	a, b := l.Points[i], l.Points[i+1]
+	k := math.Cos(radians(a.Latitude))
+	bx, by := (b.Longitude-a.Longitude)*k, b.Latitude-a.Latitude
+	px, py := (p.Longitude-a.Longitude)*k, p.Latitude-a.Latitude
+
+	t := 0.0
+	if length := bx*bx + by*by; length > 0 {
+		t = math.Max(0, math.Min(1, (px*bx+py*by)/length))
+	}
+	point := interpolate(a, b, t)
+	return Projection{
+		Point:         point,
+		DistanceAlong: l.cumulative[i] + t*(l.cumulative[i+1]-l.cumulative[i]),
+		Offset:        Distance(p, point),
+	}
+}
+
+func interpolate(a, b Point, t float64) Point {
+	return Point{
+		Latitude:  a.Latitude + t*(b.Latitude-a.Latitude),
+		Longitude: a.Longitude + t*(b.Longitude-a.Longitude),
+	}
+}
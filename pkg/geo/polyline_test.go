@@ -0,0 +1,46 @@
+package geo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolylineProject(t *testing.T) {
+	// Г-образная линия: ~1112 м на север, затем ~580 м на восток
+	line := NewPolyline([]Point{
+		{Latitude: 58.60, Longitude: 49.60},
+		{Latitude: 58.61, Longitude: 49.60},
+		{Latitude: 58.61, Longitude: 49.61},
+	})
+	require.InDelta(t, 1112+580, line.Length(), 5)
+
+	p := line.Project(Point{Latitude: 58.605, Longitude: 49.601})
+	require.InDelta(t, 556, p.DistanceAlong, 2)
+	require.InDelta(t, 58, p.Offset, 2)
+	require.InDelta(t, 58.605, p.Point.Latitude, 1e-6)
+	require.InDelta(t, 49.60, p.Point.Longitude, 1e-6)
+
+	// Точка за концом линии проецируется на последнюю вершину
+	p = line.Project(Point{Latitude: 58.61, Longitude: 49.62})
+	require.InDelta(t, line.Length(), p.DistanceAlong, 1)
+
+	at := line.PointAt(556)
+	require.InDelta(t, 58.605, at.Latitude, 1e-4)
+}
+
+func TestPolylineProjectNear(t *testing.T) {
+	// Маршрут туда и обратно по одной улице
+	line := NewPolyline([]Point{
+		{Latitude: 58.60, Longitude: 49.60},
+		{Latitude: 58.61, Longitude: 49.60},
+		{Latitude: 58.60, Longitude: 49.6001},
+	})
+	p := Point{Latitude: 58.605, Longitude: 49.60005}
+
+	forward := line.ProjectNear(p, 500, 20)
+	require.InDelta(t, 556, forward.DistanceAlong, 5)
+
+	backward := line.ProjectNear(p, 1600, 20)
+	require.InDelta(t, 1112+556, backward.DistanceAlong, 5)
+}